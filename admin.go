@@ -0,0 +1,112 @@
+package messenger
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminPolicy selects the operations restricted to the admins of multi member
+// groups, see WithAdminPolicy. It is enforced by this module only, the
+// protocol letting any member do them.
+type AdminPolicy struct {
+	// Profile restricts UpdateGroupProfile and SetGroupSettings, the profiles
+	// published by other members being ignored.
+	Profile bool
+	// Invitations restricts CreateGroupInvitation.
+	Invitations bool
+	// Announcements restricts SetGroupSettings, and SendMessage in the groups
+	// set to announcement only.
+	Announcements bool
+}
+
+func (s *Service) CreateGroupInvitation(ctx context.Context, req *CreateGroupInvitationReq) (*CreateGroupInvitationRes, error) {
+	groupPK, err := base64.StdEncoding.DecodeString(req.GroupPk)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+
+	if err := s.requireAdminOf(ctx, groupPK, s.adminPolicy != nil && s.adminPolicy.Invitations); err != nil {
+		return nil, err
+	}
+
+	inv, err := s.groupInvitation(ctx, groupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateGroupInvitationRes{GroupInvitation: inv}, nil
+}
+
+func (s *Service) GrantGroupAdmin(ctx context.Context, req *GrantGroupAdminReq) (*GrantGroupAdminRes, error) {
+	groupPK, err := base64.StdEncoding.DecodeString(req.GroupPk)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+	memberPK, err := base64.StdEncoding.DecodeString(req.MemberPk)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+
+	_, err = s.client.MultiMemberGroupAdminRoleGrant(ctx, &protocoltypes.MultiMemberGroupAdminRoleGrant_Request{
+		GroupPK:  groupPK,
+		MemberPK: memberPK,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("grant error: %w", err)
+	}
+
+	return &GrantGroupAdminRes{Success: true}, nil
+}
+
+func (s *Service) ListGroupAdmins(ctx context.Context, req *ListGroupAdminsReq) (*ListGroupAdminsRes, error) {
+	members, err := s.ListGroupMembers(ctx, &ListGroupMembersReq{Pubkey: req.GroupPk})
+	if err != nil {
+		return nil, err
+	}
+
+	var admins []*GroupMember
+	for _, member := range members.Members {
+		if member.Admin {
+			admins = append(admins, member)
+		}
+	}
+
+	return &ListGroupAdminsRes{Admins: admins}, nil
+}
+
+// requireAdminOf returns a PermissionDenied error if restricted is set and our
+// member is not an admin of the group.
+func (s *Service) requireAdminOf(ctx context.Context, groupPK []byte, restricted bool) error {
+	if !restricted {
+		return nil
+	}
+
+	group, err := s.groupInfo(ctx, base64.StdEncoding.EncodeToString(groupPK), false)
+	if err != nil {
+		return err
+	}
+	return s.requireAdmin(ctx, group)
+}
+
+// requireAdmin returns a PermissionDenied error if our member is not an admin
+// of the group. Only multi member groups have admins.
+func (s *Service) requireAdmin(ctx context.Context, group *protocoltypes.GroupInfo_Reply) error {
+	if group.Group.GetGroupType() != protocoltypes.GroupTypeMultiMember {
+		return nil
+	}
+
+	book, err := s.replayMemberBook(ctx, group)
+	if err != nil {
+		return err
+	}
+
+	if self := book.byPK[base64.StdEncoding.EncodeToString(group.MemberPK)]; self == nil || !self.Admin {
+		return status.Error(codes.PermissionDenied, "not an admin of the group")
+	}
+	return nil
+}
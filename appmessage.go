@@ -0,0 +1,105 @@
+package messenger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"berty.tech/berty/v2/go/pkg/messengertypes"
+)
+
+var appMessageTypes = map[AppMessageType]messengertypes.AppMessage_Type{
+	AppMessageType_APP_MESSAGE_TYPE_USER_MESSAGE:     messengertypes.AppMessage_TypeUserMessage,
+	AppMessageType_APP_MESSAGE_TYPE_ACKNOWLEDGE:      messengertypes.AppMessage_TypeAcknowledge,
+	AppMessageType_APP_MESSAGE_TYPE_GROUP_INVITATION: messengertypes.AppMessage_TypeGroupInvitation,
+	AppMessageType_APP_MESSAGE_TYPE_SET_USER_INFO:    messengertypes.AppMessage_TypeSetUserInfo,
+}
+
+// encodeAppMessage returns req as an AppMessage understood by the Berty
+// Messenger app. Messages without type are user messages.
+func encodeAppMessage(req *SendMessageReq, sentDate time.Time) ([]byte, error) {
+	if req.Payload != nil {
+		return nil, errors.New("payload is not supported with the messenger format")
+	}
+
+	msgType := req.AppMessageType
+	if msgType == AppMessageType_APP_MESSAGE_TYPE_UNDEFINED {
+		msgType = AppMessageType_APP_MESSAGE_TYPE_USER_MESSAGE
+	}
+
+	var (
+		payload []byte
+		err     error
+	)
+	switch msgType {
+	case AppMessageType_APP_MESSAGE_TYPE_USER_MESSAGE:
+		payload, err = (&messengertypes.AppMessage_UserMessage{Body: req.Message}).Marshal()
+	case AppMessageType_APP_MESSAGE_TYPE_ACKNOWLEDGE:
+		if req.TargetId == "" {
+			return nil, errors.New("acknowledge requires a target id")
+		}
+		payload, err = (&messengertypes.AppMessage_Acknowledge{}).Marshal()
+	case AppMessageType_APP_MESSAGE_TYPE_GROUP_INVITATION:
+		payload, err = (&messengertypes.AppMessage_GroupInvitation{Link: req.Message}).Marshal()
+	case AppMessageType_APP_MESSAGE_TYPE_SET_USER_INFO:
+		payload, err = (&messengertypes.AppMessage_SetUserInfo{DisplayName: req.Message}).Marshal()
+	default:
+		return nil, fmt.Errorf("unsupported app message type %s", msgType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return (&messengertypes.AppMessage{
+		Type:      appMessageTypes[msgType],
+		Payload:   payload,
+		SentDate:  sentDate.UnixMilli(),
+		TargetCID: req.TargetId,
+	}).Marshal()
+}
+
+// decodeAppMessage fills res from raw if it is an AppMessage of a supported
+// type, and reports whether it did.
+func decodeAppMessage(raw []byte, res *ListMessagesRes) bool {
+	am := &messengertypes.AppMessage{}
+	if err := am.Unmarshal(raw); err != nil {
+		return false
+	}
+
+	var text string
+	switch am.Type {
+	case messengertypes.AppMessage_TypeUserMessage:
+		payload := &messengertypes.AppMessage_UserMessage{}
+		if err := payload.Unmarshal(am.Payload); err != nil {
+			return false
+		}
+		text = payload.Body
+	case messengertypes.AppMessage_TypeAcknowledge:
+	case messengertypes.AppMessage_TypeGroupInvitation:
+		payload := &messengertypes.AppMessage_GroupInvitation{}
+		if err := payload.Unmarshal(am.Payload); err != nil {
+			return false
+		}
+		text = payload.Link
+	case messengertypes.AppMessage_TypeSetUserInfo:
+		payload := &messengertypes.AppMessage_SetUserInfo{}
+		if err := payload.Unmarshal(am.Payload); err != nil {
+			return false
+		}
+		text = payload.DisplayName
+	default:
+		return false
+	}
+
+	for msgType, amType := range appMessageTypes {
+		if amType == am.Type {
+			res.AppMessageType = msgType
+		}
+	}
+	res.Message, res.ValidUtf8 = textOf([]byte(text))
+	res.Payload = []byte(text)
+	res.TargetId = am.TargetCID
+	res.SentDate = am.SentDate
+
+	return true
+}
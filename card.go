@@ -0,0 +1,38 @@
+package messenger
+
+import (
+	"bytes"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// cardMagic prefixes the contact cards in contact request metadata, to tell
+// them apart from the plain names sent by older versions.
+var cardMagic = []byte("akc\x01")
+
+const contactCardVersion = 1
+
+// encodeContactCard returns the contact request metadata carrying card.
+func encodeContactCard(card *ContactCard) ([]byte, error) {
+	card.Version = contactCardVersion
+	raw, err := proto.Marshal(card)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, cardMagic...), raw...), nil
+}
+
+// decodeContactCard returns the card in contact request metadata, reading
+// anything else as a plain name.
+func decodeContactCard(raw []byte) *ContactCard {
+	if bytes.HasPrefix(raw, cardMagic) {
+		card := &ContactCard{}
+		if err := proto.Unmarshal(raw[len(cardMagic):], card); err == nil {
+			return card
+		}
+	}
+
+	name, _ := textOf(raw)
+	return &ContactCard{DisplayName: name}
+}
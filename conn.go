@@ -0,0 +1,90 @@
+package messenger
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// nodeBackoff is the backoff used between reconnection attempts to the node.
+var nodeBackoff = backoff.Config{
+	BaseDelay:  time.Second,
+	Multiplier: 1.6,
+	Jitter:     0.2,
+	MaxDelay:   30 * time.Second,
+}
+
+func dialNode(ctx context.Context, nodeAddr string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           nodeBackoff,
+			MinConnectTimeout: 10 * time.Second,
+		}),
+	}, opts...)
+
+	return grpc.DialContext(ctx, nodeAddr, opts...)
+}
+
+// watchConn keeps the node connection out of the idle state so that it is
+// re-established in the background instead of on the next RPC.
+func (s *Service) watchConn() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	go func() {
+		for {
+			state := s.conn.GetState()
+			s.logger.Printf("node connection state: %s", state)
+			if state == connectivity.Idle {
+				s.conn.Connect()
+			}
+			if !s.conn.WaitForStateChange(ctx, state) {
+				return
+			}
+		}
+	}()
+}
+
+// State returns the connectivity state of the connection to the node.
+// A Service built with WithProtocolClient always reports Ready.
+func (s *Service) State() connectivity.State {
+	if s.conn == nil {
+		return connectivity.Ready
+	}
+	return s.conn.GetState()
+}
+
+// WaitForStateChange blocks until the connectivity state of the node
+// connection differs from source or ctx expires, in which case it returns false.
+func (s *Service) WaitForStateChange(ctx context.Context, source connectivity.State) bool {
+	if s.conn == nil {
+		<-ctx.Done()
+		return false
+	}
+	return s.conn.WaitForStateChange(ctx, source)
+}
+
+// Close stops the reconnection loop and closes the connection to the node.
+// A client given with WithProtocolClient is left open for its owner to close.
+// Later calls return the error of the first one.
+func (s *Service) Close() error {
+	s.closeOnce.Do(func() {
+		if s.conn != nil {
+			s.cancel()
+			s.closeErr = s.conn.Close()
+		}
+
+		for _, closer := range s.closers {
+			if err := closer(); err != nil && s.closeErr == nil {
+				s.closeErr = err
+			}
+		}
+	})
+
+	return s.closeErr
+}
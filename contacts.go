@@ -0,0 +1,447 @@
+package messenger
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) DiscardContactRequest(ctx context.Context, req *DiscardContactRequestReq) (*DiscardContactRequestRes, error) {
+	decodedPubkey, err := base64.StdEncoding.DecodeString(req.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+
+	_, err = s.client.ContactRequestDiscard(ctx, &protocoltypes.ContactRequestDiscard_Request{
+		ContactPK: decodedPubkey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("discard error: %w", err)
+	}
+
+	return &DiscardContactRequestRes{Success: true}, nil
+}
+
+func (s *Service) BlockContact(ctx context.Context, req *BlockContactReq) (*BlockContactRes, error) {
+	decodedPubkey, err := base64.StdEncoding.DecodeString(req.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+
+	_, err = s.client.ContactBlock(ctx, &protocoltypes.ContactBlock_Request{
+		ContactPK: decodedPubkey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("block error: %w", err)
+	}
+
+	return &BlockContactRes{Success: true}, nil
+}
+
+func (s *Service) UnblockContact(ctx context.Context, req *UnblockContactReq) (*UnblockContactRes, error) {
+	decodedPubkey, err := base64.StdEncoding.DecodeString(req.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+
+	_, err = s.client.ContactUnblock(ctx, &protocoltypes.ContactUnblock_Request{
+		ContactPK: decodedPubkey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unblock error: %w", err)
+	}
+
+	return &UnblockContactRes{Success: true}, nil
+}
+
+func (s *Service) ListOutgoingContactRequests(ctx context.Context, _ *ListOutgoingContactRequestsReq) (*ListOutgoingContactRequestsRes, error) {
+	contacts, err := s.replayContacts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []*ListOutgoingContactRequestsRes_OutgoingContactRequest
+	for _, contact := range contacts {
+		if contact.Direction != ContactDirection_CONTACT_DIRECTION_OUTGOING {
+			continue
+		}
+
+		requestStatus, err := s.outgoingStatus(ctx, contact)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, &ListOutgoingContactRequestsRes_OutgoingContactRequest{
+			PublicKey: contact.PublicKey,
+			Name:      contact.Name,
+			Status:    requestStatus,
+			CreatedAt: contact.CreatedAt,
+			UpdatedAt: contact.UpdatedAt,
+		})
+	}
+
+	return &ListOutgoingContactRequestsRes{Requests: requests}, nil
+}
+
+func (s *Service) WatchContactRequests(req *WatchContactRequestsReq, stream MessengerSvc_WatchContactRequestsServer) error {
+	ctx := stream.Context()
+
+	var (
+		book *contactBook
+		err  error
+	)
+	if req.ReplayHistory {
+		book, err = s.newContactBook(ctx)
+	} else {
+		book, err = s.replayContactBook(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.tailContactBook(ctx, book, req.ReplayHistory, func(meta *protocoltypes.GroupMetadataEvent, contact *Contact) error {
+		eventType, ok := contactRequestEventTypes[meta.Metadata.EventType]
+		if !ok {
+			return nil
+		}
+
+		id, err := cidString(meta.EventContext.GetID())
+		if err != nil {
+			return fmt.Errorf("event id error: %w", err)
+		}
+
+		err = stream.Send(&ContactRequestEvent{
+			Id:      id,
+			Type:    eventType,
+			Contact: contact,
+		})
+		if err != nil {
+			return fmt.Errorf("send error: %w", err)
+		}
+		return nil
+	})
+}
+
+var contactRequestEventTypes = map[protocoltypes.EventType]ContactRequestEventType{
+	protocoltypes.EventTypeAccountContactRequestIncomingReceived:  ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_RECEIVED,
+	protocoltypes.EventTypeAccountContactRequestIncomingAccepted:  ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_ACCEPTED,
+	protocoltypes.EventTypeAccountContactRequestIncomingDiscarded: ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_DISCARDED,
+}
+
+func (s *Service) ListContacts(ctx context.Context, req *ListContactsReq) (*ListContactsRes, error) {
+	contacts, err := s.replayContacts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.contactEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byPK := map[string]*ContactEntry{}
+	for _, entry := range entries {
+		byPK[entry.PublicKey] = entry
+	}
+
+	for _, contact := range contacts {
+		if contact.State == ContactState_CONTACT_STATE_OUTGOING_SENT {
+			// the account group only records that our request was sent, the
+			// contact accepting it shows in the contact group
+			status, err := s.outgoingStatus(ctx, contact)
+			if err != nil {
+				return nil, err
+			}
+			if status == OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_ACCEPTED {
+				contact.State = ContactState_CONTACT_STATE_ACCEPTED
+			}
+		}
+		if contact.Verified, err = s.isVerified(ctx, contact.PublicKey); err != nil {
+			return nil, err
+		}
+		if entry, ok := byPK[contact.PublicKey]; ok {
+			contact.Nickname, contact.Tags, contact.Notes = entry.Nickname, entry.Tags, entry.Notes
+		}
+	}
+
+	if req.ResolveAliasKeys {
+		for _, contact := range contacts {
+			if contact.State != ContactState_CONTACT_STATE_ACCEPTED && contact.State != ContactState_CONTACT_STATE_OUTGOING_SENT {
+				continue
+			}
+			if contact.AliasKeyAdded, err = s.hasAliasKey(ctx, contact.PublicKey); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &ListContactsRes{Contacts: contacts}, nil
+}
+
+// replayContacts returns the contacts known to the account group metadata, in
+// the order they appeared.
+func (s *Service) replayContacts(ctx context.Context) ([]*Contact, error) {
+	book, err := s.replayContactBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return book.contacts, nil
+}
+
+// contactBook folds the account group metadata into contacts.
+type contactBook struct {
+	groupPK  []byte
+	lastID   []byte
+	contacts []*Contact
+	byPK     map[string]*Contact
+	// receivedIDs holds the ID of the last request received from each contact.
+	receivedIDs map[string][]byte
+}
+
+func (s *Service) newContactBook(ctx context.Context) (*contactBook, error) {
+	config, err := s.client.InstanceGetConfiguration(ctx, &protocoltypes.InstanceGetConfiguration_Request{})
+	if err != nil {
+		return nil, fmt.Errorf("get config error: %w", err)
+	}
+
+	return &contactBook{
+		groupPK:     config.AccountGroupPK,
+		byPK:        map[string]*Contact{},
+		receivedIDs: map[string][]byte{},
+	}, nil
+}
+
+// replayContactBook returns a book of the account group metadata up to now.
+func (s *Service) replayContactBook(ctx context.Context) (*contactBook, error) {
+	book, err := s.newContactBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.replayMetadata(ctx, book.groupPK, func(meta *protocoltypes.GroupMetadataEvent) error {
+		_, err := s.applyContactEvent(ctx, book, meta)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+// tailContactBook applies the events following the book to it and calls fn
+// with each contact they change, until ctx is done. An empty book is
+// replayed from the start of the account group if replay is true.
+func (s *Service) tailContactBook(ctx context.Context, book *contactBook, replay bool, fn func(*protocoltypes.GroupMetadataEvent, *Contact) error) error {
+	return s.tailMetadata(ctx, book.groupPK, book.lastID, replay, func(meta *protocoltypes.GroupMetadataEvent) error {
+		contact, err := s.applyContactEvent(ctx, book, meta)
+		if err != nil || contact == nil {
+			return err
+		}
+		return fn(meta, contact)
+	})
+}
+
+// applyContactEvent updates the book with meta and returns the contact it
+// changed, if any.
+func (s *Service) applyContactEvent(ctx context.Context, book *contactBook, meta *protocoltypes.GroupMetadataEvent) (*Contact, error) {
+	if id := meta.GetEventContext().GetID(); id != nil {
+		book.lastID = id
+	}
+
+	contactPK, apply, err := contactEvent(meta)
+	if err != nil {
+		return nil, err
+	}
+	if apply == nil {
+		return nil, nil
+	}
+
+	seen, err := s.seenAt(ctx, meta.EventContext.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	pk := base64.StdEncoding.EncodeToString(contactPK)
+	contact, ok := book.byPK[pk]
+	if !ok {
+		contact = &Contact{PublicKey: pk, CreatedAt: seen.Unix()}
+		book.byPK[pk] = contact
+		book.contacts = append(book.contacts, contact)
+	}
+	contact.UpdatedAt = seen.Unix()
+	apply(contact)
+	if meta.Metadata.EventType == protocoltypes.EventTypeAccountContactRequestIncomingReceived {
+		book.receivedIDs[pk] = meta.EventContext.GetID()
+	}
+
+	return contact, nil
+}
+
+// contactEvent decodes meta if it is about a contact, returning the contact
+// PK and the change to apply to it.
+func contactEvent(meta *protocoltypes.GroupMetadataEvent) ([]byte, func(*Contact), error) {
+	if meta == nil || meta.Metadata == nil {
+		return nil, nil, nil
+	}
+
+	switch meta.Metadata.EventType {
+	case protocoltypes.EventTypeAccountContactRequestOutgoingEnqueued:
+		casted := &protocoltypes.AccountContactRequestEnqueued{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		return casted.Contact.GetPK(), func(c *Contact) {
+			c.State = ContactState_CONTACT_STATE_OUTGOING_ENQUEUED
+			c.Direction = ContactDirection_CONTACT_DIRECTION_OUTGOING
+			if len(casted.Contact.GetMetadata()) != 0 {
+				c.Card = decodeContactCard(casted.Contact.GetMetadata())
+				c.Name = c.Card.DisplayName
+			}
+		}, nil
+	case protocoltypes.EventTypeAccountContactRequestOutgoingSent:
+		casted := &protocoltypes.AccountContactRequestSent{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		return casted.ContactPK, func(c *Contact) {
+			c.State = ContactState_CONTACT_STATE_OUTGOING_SENT
+			c.Direction = ContactDirection_CONTACT_DIRECTION_OUTGOING
+		}, nil
+	case protocoltypes.EventTypeAccountContactRequestIncomingReceived:
+		casted := &protocoltypes.AccountContactRequestReceived{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		return casted.ContactPK, func(c *Contact) {
+			c.State = ContactState_CONTACT_STATE_INCOMING_RECEIVED
+			c.Direction = ContactDirection_CONTACT_DIRECTION_INCOMING
+			c.Card = decodeContactCard(casted.ContactMetadata)
+			c.Name = c.Card.DisplayName
+		}, nil
+	case protocoltypes.EventTypeAccountContactRequestIncomingAccepted:
+		casted := &protocoltypes.AccountContactRequestAccepted{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		return casted.ContactPK, func(c *Contact) {
+			c.State = ContactState_CONTACT_STATE_ACCEPTED
+		}, nil
+	case protocoltypes.EventTypeAccountContactRequestIncomingDiscarded:
+		casted := &protocoltypes.AccountContactRequestDiscarded{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		return casted.ContactPK, func(c *Contact) {
+			c.State = ContactState_CONTACT_STATE_DISCARDED
+		}, nil
+	case protocoltypes.EventTypeAccountContactBlocked:
+		casted := &protocoltypes.AccountContactBlocked{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		return casted.ContactPK, func(c *Contact) {
+			c.Blocked = true
+		}, nil
+	case protocoltypes.EventTypeAccountContactUnblocked:
+		casted := &protocoltypes.AccountContactUnblocked{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		return casted.ContactPK, func(c *Contact) {
+			c.Blocked = false
+		}, nil
+	}
+
+	return nil, nil, nil
+}
+
+// hasAliasKey reports whether the contact disclosed an alias key in the
+// contact group.
+func (s *Service) hasAliasKey(ctx context.Context, contactPK string) (bool, error) {
+	decodedPK, err := base64.StdEncoding.DecodeString(contactPK)
+	if err != nil {
+		return false, fmt.Errorf("decode error: %w", err)
+	}
+	group, err := s.contactGroup(ctx, decodedPK)
+	if err != nil || group == nil {
+		return false, err
+	}
+
+	found := false
+	err = s.replayMetadata(ctx, group.Group.PublicKey, func(meta *protocoltypes.GroupMetadataEvent) error {
+		if meta.Metadata.EventType != protocoltypes.EventTypeContactAliasKeyAdded {
+			return nil
+		}
+		casted := &protocoltypes.ContactAddAliasKey{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return fmt.Errorf("unmarshal error: %w", err)
+		}
+		found = found || !bytes.Equal(casted.DevicePK, group.DevicePK)
+		return nil
+	})
+	return found, err
+}
+
+// contactJoined reports whether a device of the contact joined the contact
+// group, meaning it accepted our request.
+func (s *Service) contactJoined(ctx context.Context, contactPK []byte) (bool, error) {
+	group, err := s.contactGroup(ctx, contactPK)
+	if err != nil || group == nil {
+		return false, err
+	}
+
+	joined := false
+	err = s.replayMetadata(ctx, group.Group.PublicKey, func(meta *protocoltypes.GroupMetadataEvent) error {
+		if meta.Metadata.EventType != protocoltypes.EventTypeGroupMemberDeviceAdded {
+			return nil
+		}
+		casted := &protocoltypes.GroupAddMemberDevice{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return fmt.Errorf("unmarshal error: %w", err)
+		}
+		joined = joined || !bytes.Equal(casted.MemberPK, group.MemberPK)
+		return nil
+	})
+	return joined, err
+}
+
+// contactGroup returns the contact group shared with the contact, or nil
+// if the protocol does not know it yet.
+func (s *Service) contactGroup(ctx context.Context, contactPK []byte) (*protocoltypes.GroupInfo_Reply, error) {
+	group, err := s.client.GroupInfo(ctx, &protocoltypes.GroupInfo_Request{
+		ContactPK: contactPK,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("contact group info error: %w", err)
+	}
+	return group, nil
+}
+
+// outgoingStatus returns the progress of our request to the contact.
+func (s *Service) outgoingStatus(ctx context.Context, contact *Contact) (OutgoingRequestStatus, error) {
+	switch contact.State {
+	case ContactState_CONTACT_STATE_OUTGOING_ENQUEUED:
+		return OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_ENQUEUED, nil
+	case ContactState_CONTACT_STATE_OUTGOING_SENT:
+		contactPK, err := base64.StdEncoding.DecodeString(contact.PublicKey)
+		if err != nil {
+			return 0, fmt.Errorf("decode error: %w", err)
+		}
+		joined, err := s.contactJoined(ctx, contactPK)
+		if err != nil {
+			return 0, err
+		}
+		if joined {
+			return OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_ACCEPTED, nil
+		}
+		return OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_SENT, nil
+	}
+
+	return OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_UNKNOWN, nil
+}
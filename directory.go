@@ -0,0 +1,152 @@
+package messenger
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var directoryKey = datastore.NewKey("/contacts/directory")
+
+func (s *Service) SetContactEntry(ctx context.Context, req *SetContactEntryReq) (*SetContactEntryRes, error) {
+	if req.Entry == nil {
+		return nil, errors.New("missing entry")
+	}
+	key, err := entryKey(req.Entry.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Entry.Nickname != "" {
+		other, err := s.entryByNickname(ctx, req.Entry.Nickname)
+		if err == nil && other.PublicKey != req.Entry.PublicKey {
+			return nil, fmt.Errorf("nickname %q is already used by %s", req.Entry.Nickname, other.PublicKey)
+		}
+		if err != nil && !errors.Is(err, datastore.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	raw, err := proto.Marshal(req.Entry)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(ctx, key, raw); err != nil {
+		return nil, fmt.Errorf("store error: %w", err)
+	}
+
+	return &SetContactEntryRes{Success: true}, nil
+}
+
+func (s *Service) GetContactEntry(ctx context.Context, req *GetContactEntryReq) (*GetContactEntryRes, error) {
+	key, err := entryKey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := s.store.Get(ctx, key)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "contact entry not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store error: %w", err)
+	}
+	entry := &ContactEntry{}
+	if err := proto.Unmarshal(raw, entry); err != nil {
+		return nil, fmt.Errorf("entry error: %w", err)
+	}
+
+	return &GetContactEntryRes{Entry: entry}, nil
+}
+
+func (s *Service) ListContactEntries(ctx context.Context, req *ListContactEntriesReq) (*ListContactEntriesRes, error) {
+	entries, err := s.contactEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Tag == "" {
+		return &ListContactEntriesRes{Entries: entries}, nil
+	}
+
+	var tagged []*ContactEntry
+	for _, entry := range entries {
+		for _, tag := range entry.Tags {
+			if tag == req.Tag {
+				tagged = append(tagged, entry)
+				break
+			}
+		}
+	}
+	return &ListContactEntriesRes{Entries: tagged}, nil
+}
+
+func (s *Service) DeleteContactEntry(ctx context.Context, req *DeleteContactEntryReq) (*DeleteContactEntryRes, error) {
+	key, err := entryKey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Delete(ctx, key); err != nil {
+		return nil, fmt.Errorf("store error: %w", err)
+	}
+
+	return &DeleteContactEntryRes{Success: true}, nil
+}
+
+// entryByNickname returns the directory entry with the given nickname, or an
+// error wrapping datastore.ErrNotFound.
+func (s *Service) entryByNickname(ctx context.Context, nickname string) (*ContactEntry, error) {
+	entries, err := s.contactEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.Nickname == nickname {
+			return entry, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown nickname %q: %w", nickname, datastore.ErrNotFound)
+}
+
+// contactEntries returns the entries of the contact directory.
+func (s *Service) contactEntries(ctx context.Context) ([]*ContactEntry, error) {
+	results, err := s.store.Query(ctx, query.Query{Prefix: directoryKey.String(), Orders: []query.Order{query.OrderByKey{}}})
+	if err != nil {
+		return nil, fmt.Errorf("store error: %w", err)
+	}
+	rows, err := results.Rest()
+	if err != nil {
+		return nil, fmt.Errorf("store error: %w", err)
+	}
+
+	entries := make([]*ContactEntry, 0, len(rows))
+	for _, row := range rows {
+		entry := &ContactEntry{}
+		if err := proto.Unmarshal(row.Value, entry); err != nil {
+			return nil, fmt.Errorf("entry error: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// entryKey returns the datastore key of the entry for the base64 key pk.
+func entryKey(pk string) (datastore.Key, error) {
+	contactPK, err := base64.StdEncoding.DecodeString(pk)
+	if err != nil {
+		return datastore.Key{}, fmt.Errorf("decode error: %w", err)
+	}
+	if len(contactPK) == 0 {
+		return datastore.Key{}, errors.New("missing contact public key")
+	}
+
+	return directoryKey.ChildString(base64.RawURLEncoding.EncodeToString(contactPK)), nil
+}
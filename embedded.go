@@ -0,0 +1,123 @@
+package messenger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"berty.tech/berty/v2/go/pkg/bertyprotocol"
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	dssync "github.com/ipfs/go-datastore/sync"
+	leveldb "github.com/ipfs/go-ds-leveldb"
+)
+
+// EmbeddedOption configures the Berty protocol service booted by NewEmbedded.
+type EmbeddedOption func(*embeddedOptions)
+
+type embeddedOptions struct {
+	datastoreDir string
+	inMemoryIPFS bool
+	protocolOpts []func(*bertyprotocol.Opts)
+	serviceOpts  []Option
+}
+
+// WithDatastoreDir persists the protocol state, including the account keys,
+// in dir. The state is kept in memory by default.
+func WithDatastoreDir(dir string) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.datastoreDir = dir
+	}
+}
+
+// WithInMemoryIPFS lets the protocol service start an in-memory IPFS node that
+// cannot reach any peer. It is meant for tests; use WithProtocolOpts to give
+// a real IPFS core API otherwise.
+func WithInMemoryIPFS() EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.inMemoryIPFS = true
+	}
+}
+
+// WithProtocolOpts lets fn adjust the options of the protocol service before
+// it is started, e.g. to set its IPFS core API or logger.
+func WithProtocolOpts(fn func(*bertyprotocol.Opts)) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.protocolOpts = append(o.protocolOpts, fn)
+	}
+}
+
+// WithServiceOptions sets the options of the returned Service. Node dialing
+// options do not apply to an embedded node. The Service keeps its state in the
+// protocol datastore unless WithDatastore is given.
+func WithServiceOptions(opts ...Option) EmbeddedOption {
+	return func(o *embeddedOptions) {
+		o.serviceOpts = append(o.serviceOpts, opts...)
+	}
+}
+
+// NewEmbedded boots a Berty protocol service inside the process and returns a
+// Service wired to it. Closing the Service stops the protocol service.
+func NewEmbedded(ctx context.Context, opts ...EmbeddedOption) (*Service, error) {
+	var o embeddedOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	rootDS, err := o.rootDatastore()
+	if err != nil {
+		return nil, fmt.Errorf("datastore error: %w", err)
+	}
+
+	popts := bertyprotocol.Opts{
+		DatastoreDir:  o.datastoreDir,
+		RootDatastore: rootDS,
+	}
+	for _, fn := range o.protocolOpts {
+		fn(&popts)
+	}
+
+	if popts.IpfsCoreAPI == nil && !o.inMemoryIPFS {
+		_ = rootDS.Close()
+		return nil, errors.New("an IPFS core API is required, set one with WithProtocolOpts or use WithInMemoryIPFS")
+	}
+
+	svc, err := bertyprotocol.New(ctx, popts)
+	if err != nil {
+		_ = rootDS.Close()
+		return nil, fmt.Errorf("protocol error: %w", err)
+	}
+
+	client, err := bertyprotocol.NewClient(ctx, svc, nil, nil)
+	if err != nil {
+		_ = svc.Close()
+		_ = rootDS.Close()
+		return nil, fmt.Errorf("protocol client error: %w", err)
+	}
+
+	serviceOpts := append([]Option{WithDatastore(namespace.Wrap(rootDS, datastore.NewKey("messenger")))}, o.serviceOpts...)
+	s, err := NewWithOptions(ctx, append(serviceOpts, WithProtocolClient(client))...)
+	if err != nil {
+		_ = client.Close()
+		_ = svc.Close()
+		_ = rootDS.Close()
+		return nil, err
+	}
+	s.closers = append(s.closers, client.Close, svc.Close, rootDS.Close)
+
+	return s, nil
+}
+
+func (o *embeddedOptions) rootDatastore() (datastore.Batching, error) {
+	if o.datastoreDir == "" {
+		return dssync.MutexWrap(datastore.NewMapDatastore()), nil
+	}
+
+	if err := os.MkdirAll(o.datastoreDir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return leveldb.NewDatastore(filepath.Join(o.datastoreDir, "datastore"), nil)
+}
@@ -0,0 +1,38 @@
+//go:build integration
+
+// The embedded node needs a working Berty protocol, run with -tags integration.
+
+package messenger_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	messenger "github.com/adapterkit/adapterkit-module-berty-messenger"
+)
+
+func TestEmbeddedPersistsAccount(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	dir := t.TempDir()
+
+	pubkey := func() string {
+		s, err := messenger.NewEmbedded(ctx, messenger.WithDatastoreDir(dir), messenger.WithInMemoryIPFS())
+		if err != nil {
+			t.Fatalf("new embedded: %v", err)
+		}
+		defer s.Close()
+
+		res, err := s.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+		if err != nil {
+			t.Fatalf("get pubkey: %v", err)
+		}
+		return res.Pubkey
+	}
+
+	first := pubkey()
+	if second := pubkey(); second != first {
+		t.Fatalf("account changed across restarts: %q != %q", first, second)
+	}
+}
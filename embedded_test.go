@@ -0,0 +1,14 @@
+package messenger_test
+
+import (
+	"context"
+	"testing"
+
+	messenger "github.com/adapterkit/adapterkit-module-berty-messenger"
+)
+
+func TestNewEmbeddedRequiresIPFS(t *testing.T) {
+	if _, err := messenger.NewEmbedded(context.Background()); err == nil {
+		t.Fatal("expected an error without IPFS core API")
+	}
+}
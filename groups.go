@@ -0,0 +1,238 @@
+package messenger
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+var activeGroupsKey = datastore.NewKey("/groups/active")
+
+// subscriptions tracks the running message subscriptions by group, to end
+// them when the group is left.
+type subscriptions struct {
+	mu      sync.Mutex
+	next    int
+	byGroup map[string]map[int]context.CancelFunc
+}
+
+// add registers the subscription to groupPK ended by cancel, until remove is
+// called.
+func (r *subscriptions) add(groupPK []byte, cancel context.CancelFunc) (remove func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.byGroup == nil {
+		r.byGroup = make(map[string]map[int]context.CancelFunc)
+	}
+	if r.byGroup[string(groupPK)] == nil {
+		r.byGroup[string(groupPK)] = make(map[int]context.CancelFunc)
+	}
+	id := r.next
+	r.next++
+	r.byGroup[string(groupPK)][id] = cancel
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		delete(r.byGroup[string(groupPK)], id)
+		if len(r.byGroup[string(groupPK)]) == 0 {
+			delete(r.byGroup, string(groupPK))
+		}
+	}
+}
+
+// cancel ends the subscriptions to groupPK and returns how many there were.
+func (r *subscriptions) cancel(groupPK []byte) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subs := r.byGroup[string(groupPK)]
+	for _, cancel := range subs {
+		cancel()
+	}
+	delete(r.byGroup, string(groupPK))
+	return len(subs)
+}
+
+func (s *Service) ListGroups(ctx context.Context, req *ListGroupsReq) (*ListGroupsRes, error) {
+	config, err := s.client.InstanceGetConfiguration(ctx, &protocoltypes.InstanceGetConfiguration_Request{})
+	if err != nil {
+		return nil, fmt.Errorf("get config error: %w", err)
+	}
+
+	var groups []*Group
+	byPK := map[string]*Group{}
+	err = s.replayMetadata(ctx, config.AccountGroupPK, func(meta *protocoltypes.GroupMetadataEvent) error {
+		switch meta.Metadata.EventType {
+		case protocoltypes.EventTypeAccountGroupJoined:
+			casted := &protocoltypes.AccountGroupJoined{}
+			if err := casted.Unmarshal(meta.Event); err != nil {
+				return fmt.Errorf("unmarshal error: %w", err)
+			}
+
+			pk := base64.StdEncoding.EncodeToString(casted.Group.GetPublicKey())
+			if group, ok := byPK[pk]; ok {
+				group.Left = false
+				return nil
+			}
+
+			seen, err := s.seenAt(ctx, meta.EventContext.GetID())
+			if err != nil {
+				return err
+			}
+			group := &Group{
+				PublicKey: pk,
+				Type:      GroupType(casted.Group.GetGroupType()),
+				JoinedAt:  seen.Unix(),
+			}
+			byPK[pk] = group
+			groups = append(groups, group)
+		case protocoltypes.EventTypeAccountGroupLeft:
+			casted := &protocoltypes.AccountGroupLeft{}
+			if err := casted.Unmarshal(meta.Event); err != nil {
+				return fmt.Errorf("unmarshal error: %w", err)
+			}
+
+			if group, ok := byPK[base64.StdEncoding.EncodeToString(casted.GroupPK)]; ok {
+				group.Left = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make([]*Group, 0, len(groups))
+	for _, group := range groups {
+		if group.Left && !req.IncludeLeft {
+			continue
+		}
+		if group.Active, err = s.isGroupActive(ctx, group.PublicKey); err != nil {
+			return nil, err
+		}
+		if !group.Left {
+			info, err := s.groupInfo(ctx, group.PublicKey, false)
+			if err != nil {
+				return nil, err
+			}
+			group.MemberPk = base64.StdEncoding.EncodeToString(info.MemberPK)
+
+			// the metadata of inactive groups cannot be listed
+			if group.Active {
+				if group.Profile, _, err = s.groupProfile(ctx, info); err != nil {
+					return nil, err
+				}
+			}
+		}
+		listed = append(listed, group)
+	}
+
+	return &ListGroupsRes{Groups: listed}, nil
+}
+
+func (s *Service) LeaveGroup(ctx context.Context, req *LeaveGroupReq) (*LeaveGroupRes, error) {
+	groupPK, err := base64.StdEncoding.DecodeString(req.GroupPk)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+
+	_, err = s.client.MultiMemberGroupLeave(ctx, &protocoltypes.MultiMemberGroupLeave_Request{
+		GroupPK: groupPK,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("leave error: %w", err)
+	}
+
+	// the group is left, a group still open on the node is only wasted work
+	_, err = s.client.DeactivateGroup(ctx, &protocoltypes.DeactivateGroup_Request{
+		GroupPK: groupPK,
+	})
+	if err != nil {
+		s.logger.Printf("deactivate group error: %v", err)
+	}
+
+	wasActive, err := s.isGroupActive(ctx, req.GroupPk)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.setGroupActive(ctx, groupPK, false); err != nil {
+		return nil, err
+	}
+
+	return &LeaveGroupRes{
+		Success:             true,
+		WasActive:           wasActive,
+		CachedDevices:       int32(s.members.forget(groupPK)),
+		ClosedSubscriptions: int32(s.subs.cancel(groupPK)),
+	}, nil
+}
+
+// reactivateGroups activates again the groups recorded as active, the node
+// forgetting them when it restarts.
+func (s *Service) reactivateGroups() {
+	s.background(func(ctx context.Context) {
+		results, err := s.store.Query(ctx, query.Query{Prefix: activeGroupsKey.String(), KeysOnly: true})
+		if err != nil {
+			s.logger.Printf("reactivate groups error: %v", err)
+			return
+		}
+		entries, err := results.Rest()
+		if err != nil {
+			s.logger.Printf("reactivate groups error: %v", err)
+			return
+		}
+
+		for _, entry := range entries {
+			groupPK, err := base64.RawURLEncoding.DecodeString(datastore.RawKey(entry.Key).Name())
+			if err != nil {
+				s.logger.Printf("reactivate groups error: %v", err)
+				continue
+			}
+			_, err = s.client.ActivateGroup(ctx, &protocoltypes.ActivateGroup_Request{
+				GroupPK: groupPK,
+			})
+			if err != nil {
+				s.logger.Printf("activate group error: %v", err)
+			}
+		}
+	})
+}
+
+// setGroupActive records whether the group was activated through the module,
+// the protocol not reporting it.
+func (s *Service) setGroupActive(ctx context.Context, groupPK []byte, active bool) error {
+	key := activeGroupsKey.ChildString(base64.RawURLEncoding.EncodeToString(groupPK))
+
+	var err error
+	if active {
+		err = s.store.Put(ctx, key, nil)
+	} else {
+		err = s.store.Delete(ctx, key)
+	}
+	if err != nil {
+		return fmt.Errorf("store error: %w", err)
+	}
+	return nil
+}
+
+// isGroupActive reports whether the group with the base64 key pk is active.
+func (s *Service) isGroupActive(ctx context.Context, pk string) (bool, error) {
+	groupPK, err := base64.StdEncoding.DecodeString(pk)
+	if err != nil {
+		return false, fmt.Errorf("decode error: %w", err)
+	}
+
+	active, err := s.store.Has(ctx, activeGroupsKey.ChildString(base64.RawURLEncoding.EncodeToString(groupPK)))
+	if err != nil {
+		return false, fmt.Errorf("store error: %w", err)
+	}
+	return active, nil
+}
@@ -0,0 +1,154 @@
+package messenger_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"berty.tech/berty/v2/go/pkg/bertyprotocol"
+	messenger "github.com/adapterkit/adapterkit-module-berty-messenger"
+)
+
+// newProtocolClients starts count Berty protocol instances connected on a
+// libp2p mocknet and returns a messenger client in front of each of them.
+func newProtocolClients(ctx context.Context, t *testing.T, count int) []messenger.MessengerSvcClient {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("skipping protocol integration test in short mode")
+	}
+
+	tps, cleanup := bertyprotocol.NewTestingProtocolWithMockedPeers(ctx, t, &bertyprotocol.TestingOpts{
+		ConnectFunc: bertyprotocol.ConnectAll,
+	}, nil, count)
+	t.Cleanup(cleanup)
+
+	clients := make([]messenger.MessengerSvcClient, count)
+	for i, tp := range tps {
+		s, err := messenger.NewWithOptions(ctx, messenger.WithProtocolClient(tp.Client))
+		if err != nil {
+			t.Fatalf("new service: %v", err)
+		}
+		t.Cleanup(func() { _ = s.Close() })
+		clients[i] = serve(t, s)
+	}
+	return clients
+}
+
+// eventually retries fn until it succeeds or ctx expires.
+func eventually(ctx context.Context, t *testing.T, fn func() error) {
+	t.Helper()
+
+	for {
+		err := fn()
+		if err == nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			t.Fatalf("condition not met in time: %v", err)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func hasMessages(ctx context.Context, c messenger.MessengerSvcClient, req *messenger.ListMessagesReq, want ...string) func() error {
+	return func() error {
+		msgs, err := collectMessages(ctx, c, req)
+		if err != nil {
+			return err
+		}
+
+		got := map[string]bool{}
+		for _, msg := range msgs {
+			got[msg.Message] = true
+		}
+		for _, msg := range want {
+			if !got[msg] {
+				return fmt.Errorf("message %q not received", msg)
+			}
+		}
+		return nil
+	}
+}
+
+func TestProtocolContactExchange(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	clients := newProtocolClients(ctx, t, 2)
+	alice, bob := clients[0], clients[1]
+
+	aliceRef, err := alice.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	bobRef, err := bob.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+
+	if _, err := bob.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: aliceRef.Pubkey, RdvSeed: aliceRef.RdvSeed, Name: "bob"}); err != nil {
+		t.Fatalf("send contact request: %v", err)
+	}
+
+	eventually(ctx, t, func() error {
+		res, err := alice.GetContactRequests(ctx, &messenger.GetContactRequestsReq{})
+		if err != nil {
+			return err
+		}
+		for _, req := range res.ContactRequests {
+			if req.PublicKey == bobRef.Pubkey && req.Name == "bob" {
+				return nil
+			}
+		}
+		return fmt.Errorf("contact request not received, got %v", res.ContactRequests)
+	})
+
+	if _, err := alice.AcceptContactRequest(ctx, &messenger.AcceptContactRequestReq{Pubkey: bobRef.Pubkey}); err != nil {
+		t.Fatalf("accept contact request: %v", err)
+	}
+
+	if _, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: bobRef.Pubkey, Message: "hello bob", IsContact: true}); err != nil {
+		t.Fatalf("send message: %v", err)
+	}
+	eventually(ctx, t, func() error {
+		_, err := bob.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: aliceRef.Pubkey, Message: "hello alice", IsContact: true})
+		return err
+	})
+
+	eventually(ctx, t, hasMessages(ctx, bob, &messenger.ListMessagesReq{Pubkey: aliceRef.Pubkey, IsContact: true}, "hello bob", "hello alice"))
+	eventually(ctx, t, hasMessages(ctx, alice, &messenger.ListMessagesReq{Pubkey: bobRef.Pubkey, IsContact: true}, "hello bob", "hello alice"))
+}
+
+func TestProtocolGroupExchange(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	clients := newProtocolClients(ctx, t, 3)
+
+	group, err := clients[0].CreateGroup(ctx, &messenger.CreateGroupReq{})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	for _, c := range clients[1:] {
+		if _, err := c.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: group.GroupInvitation}); err != nil {
+			t.Fatalf("join group: %v", err)
+		}
+	}
+
+	var want []string
+	for i, c := range clients {
+		msg := fmt.Sprintf("hello from %d", i)
+		if _, err := c.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: group.GroupPk, Message: msg}); err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+		want = append(want, msg)
+	}
+
+	for _, c := range clients {
+		eventually(ctx, t, hasMessages(ctx, c, &messenger.ListMessagesReq{Pubkey: group.GroupPk}, want...))
+	}
+}
@@ -0,0 +1,72 @@
+package messenger
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"berty.tech/berty/v2/go/pkg/bertylinks"
+	"berty.tech/berty/v2/go/pkg/messengertypes"
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	"rsc.io/qr"
+)
+
+func (s *Service) ShareContact(ctx context.Context, req *ShareContactReq) (*ShareContactRes, error) {
+	config, err := s.client.InstanceGetConfiguration(ctx, &protocoltypes.InstanceGetConfiguration_Request{})
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := s.client.ContactRequestReference(ctx, &protocoltypes.ContactRequestReference_Request{})
+	if err != nil {
+		return nil, fmt.Errorf("ref error: %w", err)
+	}
+
+	link, webLink, err := bertylinks.MarshalLink(&messengertypes.BertyLink{
+		Kind: messengertypes.BertyLink_ContactInviteV1Kind,
+		BertyID: &messengertypes.BertyID{
+			PublicRendezvousSeed: ref.PublicRendezvousSeed,
+			AccountPK:            config.AccountPK,
+			DisplayName:          req.DisplayName,
+		},
+	}, false)
+	if err != nil {
+		return nil, fmt.Errorf("link error: %w", err)
+	}
+
+	res := &ShareContactRes{Link: link, WebLink: webLink}
+	if req.QrCode {
+		code, err := qr.Encode(webLink, qr.M)
+		if err != nil {
+			return nil, fmt.Errorf("qr code error: %w", err)
+		}
+		res.QrCodePng = code.PNG()
+	}
+
+	return res, nil
+}
+
+// contactOf returns the account key and rendezvous seed the request is sent
+// to, taken from its link if any.
+func contactOf(req *SendContactRequestReq) (pk []byte, rdvSeed []byte, err error) {
+	if req.Link == "" {
+		if pk, err = base64.StdEncoding.DecodeString(req.Pubkey); err != nil {
+			return nil, nil, fmt.Errorf("decode error: %w", err)
+		}
+		if rdvSeed, err = base64.StdEncoding.DecodeString(req.RdvSeed); err != nil {
+			return nil, nil, fmt.Errorf("decode error: %w", err)
+		}
+		return pk, rdvSeed, nil
+	}
+
+	link, err := bertylinks.UnmarshalLink(req.Link, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("link error: %w", err)
+	}
+	if link.Kind != messengertypes.BertyLink_ContactInviteV1Kind || link.BertyID == nil {
+		return nil, nil, errors.New("not a contact link")
+	}
+
+	return link.BertyID.AccountPK, link.BertyID.PublicRendezvousSeed, nil
+}
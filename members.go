@@ -0,0 +1,285 @@
+package messenger
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sync"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+)
+
+func (s *Service) ListGroupMembers(ctx context.Context, req *ListGroupMembersReq) (*ListGroupMembersRes, error) {
+	group, err := s.groupInfo(ctx, req.Pubkey, req.IsContact)
+	if err != nil {
+		return nil, err
+	}
+
+	book, err := s.replayMemberBook(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListGroupMembersRes{Members: book.members}, nil
+}
+
+func (s *Service) WatchGroupMembers(req *WatchGroupMembersReq, stream MessengerSvc_WatchGroupMembersServer) error {
+	group, err := s.groupInfo(stream.Context(), req.Pubkey, req.IsContact)
+	if err != nil {
+		return err
+	}
+
+	// the watch ends when the group is left
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	defer s.subs.add(group.Group.PublicKey, cancel)()
+
+	book := newMemberBook(group)
+	if !req.ReplayHistory {
+		if book, err = s.replayMemberBook(ctx, group); err != nil {
+			return err
+		}
+	}
+
+	return s.tailMetadata(ctx, book.groupPK, book.lastID, req.ReplayHistory, func(meta *protocoltypes.GroupMetadataEvent) error {
+		event, member, err := s.applyMemberEvent(ctx, book, meta)
+		if err != nil || member == nil {
+			return err
+		}
+
+		if event.Id, err = cidString(meta.EventContext.GetID()); err != nil {
+			return fmt.Errorf("event id error: %w", err)
+		}
+		event.Member = member
+		if err := stream.Send(event); err != nil {
+			return fmt.Errorf("send error: %w", err)
+		}
+		return nil
+	})
+}
+
+// memberBook folds the metadata of a group into its members.
+type memberBook struct {
+	groupPK  []byte
+	selfPK   []byte
+	lastID   []byte
+	members  []*GroupMember
+	byPK     map[string]*GroupMember
+	byDevice map[string]*GroupMember
+}
+
+func newMemberBook(group *protocoltypes.GroupInfo_Reply) *memberBook {
+	return &memberBook{
+		groupPK:  group.Group.PublicKey,
+		selfPK:   group.MemberPK,
+		byPK:     map[string]*GroupMember{},
+		byDevice: map[string]*GroupMember{},
+	}
+}
+
+// replayMemberBook returns a book of the group metadata up to now.
+func (s *Service) replayMemberBook(ctx context.Context, group *protocoltypes.GroupInfo_Reply) (*memberBook, error) {
+	book := newMemberBook(group)
+	err := s.replayMetadata(ctx, book.groupPK, func(meta *protocoltypes.GroupMetadataEvent) error {
+		_, _, err := s.applyMemberEvent(ctx, book, meta)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+// applyMemberEvent updates the book with meta and returns the event and the
+// member it changed, if any.
+func (s *Service) applyMemberEvent(ctx context.Context, book *memberBook, meta *protocoltypes.GroupMetadataEvent) (*GroupMemberEvent, *GroupMember, error) {
+	if id := meta.GetEventContext().GetID(); id != nil {
+		book.lastID = id
+	}
+
+	var (
+		memberPK []byte
+		event    = &GroupMemberEvent{}
+	)
+	switch meta.Metadata.EventType {
+	case protocoltypes.EventTypeGroupMemberDeviceAdded:
+		casted := &protocoltypes.GroupAddMemberDevice{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		s.members.add(book.groupPK, casted.DevicePK, casted.MemberPK)
+		memberPK = casted.MemberPK
+		event.DevicePk = base64.StdEncoding.EncodeToString(casted.DevicePK)
+	case protocoltypes.EventTypeMultiMemberGroupInitialMemberAnnounced:
+		casted := &protocoltypes.MultiMemberInitialMember{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		memberPK = casted.MemberPK
+		event.Type = GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_ADMIN_GRANTED
+	case protocoltypes.EventTypeMultiMemberGroupAdminRoleGranted:
+		casted := &protocoltypes.MultiMemberGroupAdminRoleGrant{}
+		if err := casted.Unmarshal(meta.Event); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		memberPK = casted.GranteeMemberPK
+		event.Type = GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_ADMIN_GRANTED
+	default:
+		return nil, nil, nil
+	}
+
+	pk := base64.StdEncoding.EncodeToString(memberPK)
+	member, ok := book.byPK[pk]
+	if !ok {
+		seen, err := s.seenAt(ctx, meta.EventContext.GetID())
+		if err != nil {
+			return nil, nil, err
+		}
+		member = &GroupMember{
+			MemberPk:    pk,
+			FirstSeenAt: seen.Unix(),
+			Self:        bytes.Equal(memberPK, book.selfPK),
+		}
+		book.byPK[pk] = member
+		book.members = append(book.members, member)
+	}
+
+	if event.DevicePk != "" {
+		for _, devicePK := range member.DevicePks {
+			if devicePK == event.DevicePk {
+				return nil, nil, nil
+			}
+		}
+		event.Type = GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_DEVICE_ADDED
+		if len(member.DevicePks) == 0 {
+			event.Type = GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_MEMBER_JOINED
+		}
+		member.DevicePks = append(member.DevicePks, event.DevicePk)
+		book.byDevice[event.DevicePk] = member
+	} else {
+		member.Admin = true
+	}
+
+	return event, member, nil
+}
+
+// memberCache maps the devices of a group to the member they belong to, as
+// announced in the group metadata.
+type memberCache struct {
+	mu     sync.Mutex
+	groups map[string]*groupDevices
+}
+
+// groupDevices holds the devices announced in a group, the metadata being
+// replayed up to lastID.
+type groupDevices struct {
+	devices map[string][]byte
+	lastID  []byte
+}
+
+// memberOf returns the member PK of devicePK in groupPK, replaying the group
+// metadata not replayed yet when the device is not known. It returns nil if
+// the device was never announced.
+func (c *memberCache) memberOf(ctx context.Context, client protocoltypes.ProtocolServiceClient, groupPK, devicePK []byte) ([]byte, error) {
+	if memberPK := c.get(groupPK, devicePK); memberPK != nil {
+		return memberPK, nil
+	}
+
+	sinceID := c.lastID(groupPK)
+	list, err := client.GroupMetadataList(ctx, &protocoltypes.GroupMetadataList_Request{
+		GroupPK:  groupPK,
+		SinceID:  sinceID,
+		UntilNow: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list error: %w", err)
+	}
+
+	for {
+		meta, err := list.Recv()
+		if err == io.EOF {
+			return c.get(groupPK, devicePK), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("recv error: %w", err)
+		}
+
+		id := meta.GetEventContext().GetID()
+		if sinceID != nil && bytes.Equal(id, sinceID) {
+			continue
+		}
+		if meta.GetMetadata().GetEventType() == protocoltypes.EventTypeGroupMemberDeviceAdded {
+			casted := &protocoltypes.GroupAddMemberDevice{}
+			if err := casted.Unmarshal(meta.Event); err != nil {
+				return nil, fmt.Errorf("unmarshal error: %w", err)
+			}
+			c.add(groupPK, casted.DevicePK, casted.MemberPK)
+		}
+		if id != nil {
+			c.replayed(groupPK, id)
+		}
+	}
+}
+
+func (c *memberCache) get(groupPK, devicePK []byte) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if group := c.groups[string(groupPK)]; group != nil {
+		return group.devices[string(devicePK)]
+	}
+	return nil
+}
+
+func (c *memberCache) lastID(groupPK []byte) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if group := c.groups[string(groupPK)]; group != nil {
+		return group.lastID
+	}
+	return nil
+}
+
+func (c *memberCache) add(groupPK, devicePK, memberPK []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.group(groupPK).devices[string(devicePK)] = memberPK
+}
+
+// replayed records that the metadata of groupPK was replayed up to id.
+func (c *memberCache) replayed(groupPK, id []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.group(groupPK).lastID = id
+}
+
+// group returns the devices of groupPK, c.mu being held.
+func (c *memberCache) group(groupPK []byte) *groupDevices {
+	if c.groups == nil {
+		c.groups = make(map[string]*groupDevices)
+	}
+	group := c.groups[string(groupPK)]
+	if group == nil {
+		group = &groupDevices{devices: make(map[string][]byte)}
+		c.groups[string(groupPK)] = group
+	}
+	return group
+}
+
+// forget drops the devices known for groupPK and returns how many there were.
+func (c *memberCache) forget(groupPK []byte) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count := 0
+	if group := c.groups[string(groupPK)]; group != nil {
+		count = len(group.devices)
+	}
+	delete(c.groups, string(groupPK))
+	return count
+}
@@ -1,46 +1,153 @@
 package messenger
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
+	"log"
+	"sync"
+	"time"
 
 	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	datastore "github.com/ipfs/go-datastore"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 )
 
+// New returns a MessengerSvcServer backed by the Berty node at nodeAddr.
+//
+// Deprecated: use NewWithOptions, which reports errors instead of panicking.
 func New(nodeAddr string) MessengerSvcServer {
-	_, err := grpc.Dial(nodeAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s, err := NewWithOptions(context.Background(), WithNodeAddr(nodeAddr))
 	if err != nil {
 		panic(err)
 	}
 
-	return &service{
-		NodeAddr: nodeAddr,
-	}
+	return s
 }
 
-type service struct {
-	UnimplementedMessengerSvcServer
+// NewWithOptions returns a Service talking to a Berty node, either through a
+// connection it dials itself or through the client given with WithProtocolClient.
+func NewWithOptions(ctx context.Context, opts ...Option) (*Service, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(&o)
+	}
 
-	NodeAddr string
-}
+	if o.contactPolicy != nil {
+		if err := o.contactPolicy.validate(); err != nil {
+			return nil, fmt.Errorf("contact policy error: %w", err)
+		}
+	}
+
+	s := &Service{
+		NodeAddr:    o.nodeAddr,
+		logger:      o.logger,
+		store:       o.store,
+		adminPolicy: o.adminPolicy,
+	}
+
+	if o.client != nil {
+		s.client = o.client
+		s.start(o)
+		return s, nil
+	}
+
+	if o.nodeAddr == "" {
+		return nil, errors.New("either a node address or a protocol client is required")
+	}
 
-func (s *service) GetContactPubkey(ctx context.Context, _ *GetContactPubkeyReq) (*GetContactPubkeyRes, error) {
-	conn, err := grpc.Dial(s.NodeAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if o.dialTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.dialTimeout)
+		defer cancel()
+	}
+
+	conn, err := dialNode(ctx, o.nodeAddr, o.dialOptions()...)
 	if err != nil {
 		return nil, fmt.Errorf("dial error: %w", err)
 	}
 
-	client := protocoltypes.NewProtocolServiceClient(conn)
-	config, err := client.InstanceGetConfiguration(ctx, &protocoltypes.InstanceGetConfiguration_Request{})
+	s.conn = conn
+	s.client = protocoltypes.NewProtocolServiceClient(conn)
+	s.watchConn()
+	s.start(o)
+
+	return s, nil
+}
+
+// start runs the background jobs enabled by o.
+func (s *Service) start(o options) {
+	s.reactivateGroups()
+	if o.rotateEvery > 0 {
+		s.rotateReferences(o.rotateEvery)
+	}
+	if o.contactPolicy != nil {
+		s.runContactPolicy(o.contactPolicy)
+	}
+}
+
+// background runs job in a goroutine until the service is closed, Close
+// waiting for it to return.
+func (s *Service) background(job func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	s.closers = append(s.closers, func() error {
+		cancel()
+		<-done
+		return nil
+	})
+
+	go func() {
+		defer close(done)
+		job(ctx)
+	}()
+}
+
+// sleep waits for d and returns false if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// Service implements MessengerSvcServer on top of a Berty protocol node.
+type Service struct {
+	UnimplementedMessengerSvcServer
+
+	NodeAddr string
+
+	conn    *grpc.ClientConn
+	client  protocoltypes.ProtocolServiceClient
+	cancel  context.CancelFunc
+	logger  *log.Logger
+	closers []func() error
+	members memberCache
+	subs    subscriptions
+	store   datastore.Datastore
+
+	closeOnce sync.Once
+	closeErr  error
+
+	adminPolicy *AdminPolicy
+}
+
+func (s *Service) GetContactPubkey(ctx context.Context, _ *GetContactPubkeyReq) (*GetContactPubkeyRes, error) {
+	config, err := s.client.InstanceGetConfiguration(ctx, &protocoltypes.InstanceGetConfiguration_Request{})
 	if err != nil {
 		return nil, err
 	}
 
-	ref, err := client.ContactRequestReference(ctx, &protocoltypes.ContactRequestReference_Request{})
+	ref, err := s.client.ContactRequestReference(ctx, &protocoltypes.ContactRequestReference_Request{})
 	if err != nil {
 		return nil, fmt.Errorf("ref error: %w", err)
 	}
@@ -51,122 +158,95 @@ func (s *service) GetContactPubkey(ctx context.Context, _ *GetContactPubkeyReq)
 	return &GetContactPubkeyRes{
 		Pubkey:  b64PK,
 		RdvSeed: b64RdvSeed,
+		Enabled: ref.Enabled,
 	}, nil
 }
 
-func (s *service) GetContactRequests(ctx context.Context, _ *GetContactRequestsReq) (*GetContactRequestsRes, error) {
-	conn, err := grpc.Dial(s.NodeAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return nil, fmt.Errorf("dial error: %w", err)
-	}
-	client := protocoltypes.NewProtocolServiceClient(conn)
-	config, err := client.InstanceGetConfiguration(ctx, &protocoltypes.InstanceGetConfiguration_Request{})
-	if err != nil {
-		return nil, fmt.Errorf("get config error: %w", err)
-	}
-
-	cl, err := client.GroupMetadataList(ctx, &protocoltypes.GroupMetadataList_Request{
-		GroupPK:  config.AccountGroupPK,
-		UntilNow: true,
-	})
+func (s *Service) GetContactRequests(ctx context.Context, _ *GetContactRequestsReq) (*GetContactRequestsRes, error) {
+	contacts, err := s.replayContacts(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list error: %w", err)
+		return nil, err
 	}
 
 	var contactRequests []*GetContactRequestsRes_ContactRequest
-
-	for {
-		meta, err := cl.Recv()
-		if err == io.EOF {
-			return &GetContactRequestsRes{ContactRequests: contactRequests}, nil
-		}
-		if err != nil {
-			return nil, fmt.Errorf("recv error: %w", err)
-		}
-
-		if meta == nil || meta.Metadata == nil {
+	for _, contact := range contacts {
+		if contact.State != ContactState_CONTACT_STATE_INCOMING_RECEIVED || contact.Blocked {
 			continue
 		}
-		switch meta.Metadata.EventType {
-		case protocoltypes.EventTypeAccountContactRequestIncomingReceived:
-			casted := &protocoltypes.AccountContactRequestReceived{}
-			if err := casted.Unmarshal(meta.Event); err != nil {
-				return nil, fmt.Errorf("unmarshal error: %w", err)
-			}
-			contactRequests = append(contactRequests, &GetContactRequestsRes_ContactRequest{
-				PublicKey: base64.StdEncoding.EncodeToString(casted.ContactPK),
-				Name:      string(casted.ContactMetadata),
-			})
-		case protocoltypes.EventTypeAccountContactRequestIncomingAccepted:
-			casted := &protocoltypes.AccountContactRequestAccepted{}
-			if err := casted.Unmarshal(meta.Event); err != nil {
-				return nil, fmt.Errorf("unmarshal error: %w", err)
-			}
-
-			contactRequests = RemoveMatch(contactRequests, func(request *GetContactRequestsRes_ContactRequest) bool {
-				return request.PublicKey == base64.StdEncoding.EncodeToString(casted.ContactPK)
-			})
-		case protocoltypes.EventTypeAccountContactRequestIncomingDiscarded:
-			casted := &protocoltypes.AccountContactRequestDiscarded{}
-			if err := casted.Unmarshal(meta.Event); err != nil {
-				return nil, fmt.Errorf("unmarshal error: %w", err)
-			}
-
-			contactRequests = RemoveMatch(contactRequests, func(request *GetContactRequestsRes_ContactRequest) bool {
-				return request.PublicKey == base64.StdEncoding.EncodeToString(casted.ContactPK)
-			})
-		}
+		contactRequests = append(contactRequests, &GetContactRequestsRes_ContactRequest{
+			PublicKey: contact.PublicKey,
+			Name:      contact.Name,
+			Card:      contact.Card,
+		})
 	}
+
+	return &GetContactRequestsRes{ContactRequests: contactRequests}, nil
 }
 
-func (s *service) SendContactRequest(ctx context.Context, req *SendContactRequestReq) (*SendContactRequestRes, error) {
-	conn, err := grpc.Dial(s.NodeAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func (s *Service) SendContactRequest(ctx context.Context, req *SendContactRequestReq) (*SendContactRequestRes, error) {
+	contactPK, publicRdvSeed, err := contactOf(req)
 	if err != nil {
-		return nil, fmt.Errorf("dial error: %w", err)
+		return nil, err
 	}
 
-	contactPK, err := base64.StdEncoding.DecodeString(req.Pubkey)
-	if err != nil {
-		return nil, fmt.Errorf("decode error: %w", err)
+	if req.Name == "" {
+		req.Name = "Anonymous"
 	}
 
-	publicRdvSeed, err := base64.StdEncoding.DecodeString(req.RdvSeed)
-	if err != nil {
-		return nil, fmt.Errorf("decode error: %w", err)
+	card := &ContactCard{}
+	if req.Card != nil {
+		card = proto.Clone(req.Card).(*ContactCard)
 	}
-
-	if req.Name == "" {
-		req.Name = "Anonymous"
+	if card.DisplayName == "" {
+		card.DisplayName = req.Name
+	}
+	ownMetadata, err := encodeContactCard(card)
+	if err != nil {
+		return nil, fmt.Errorf("contact card error: %w", err)
 	}
 
-	client := protocoltypes.NewProtocolServiceClient(conn)
-	_, err = client.ContactRequestSend(ctx, &protocoltypes.ContactRequestSend_Request{
+	_, err = s.client.ContactRequestSend(ctx, &protocoltypes.ContactRequestSend_Request{
 		Contact: &protocoltypes.ShareableContact{
 			PK:                   contactPK,
 			PublicRendezvousSeed: publicRdvSeed,
 		},
-		OwnMetadata: []byte(req.Name),
+		OwnMetadata: ownMetadata,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("send error: %w", err)
 	}
 
-	return &SendContactRequestRes{Success: true}, nil
+	res := &SendContactRequestRes{Success: true}
+	if res.Status, err = s.sentStatus(ctx, contactPK); err != nil {
+		// the request is sent, failing would only make the caller retry it
+		s.logger.Printf("contact request status error: %v", err)
+	}
+
+	return res, nil
 }
 
-func (s *service) AcceptContactRequest(ctx context.Context, req *AcceptContactRequestReq) (*AcceptContactRequestRes, error) {
-	conn, err := grpc.Dial(s.NodeAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// sentStatus returns the status of our request to contactPK.
+func (s *Service) sentStatus(ctx context.Context, contactPK []byte) (OutgoingRequestStatus, error) {
+	contacts, err := s.replayContacts(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("dial error: %w", err)
+		return OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_UNKNOWN, err
+	}
+
+	for _, contact := range contacts {
+		if contact.PublicKey == base64.StdEncoding.EncodeToString(contactPK) {
+			return s.outgoingStatus(ctx, contact)
+		}
 	}
+	return OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_UNKNOWN, nil
+}
 
+func (s *Service) AcceptContactRequest(ctx context.Context, req *AcceptContactRequestReq) (*AcceptContactRequestRes, error) {
 	decodedPubkey, err := base64.StdEncoding.DecodeString(req.Pubkey)
 	if err != nil {
 		return nil, err
 	}
 
-	client := protocoltypes.NewProtocolServiceClient(conn)
-	_, err = client.ContactRequestAccept(ctx, &protocoltypes.ContactRequestAccept_Request{
+	_, err = s.client.ContactRequestAccept(ctx, &protocoltypes.ContactRequestAccept_Request{
 		ContactPK: decodedPubkey,
 	})
 	if err != nil {
@@ -176,90 +256,92 @@ func (s *service) AcceptContactRequest(ctx context.Context, req *AcceptContactRe
 	return &AcceptContactRequestRes{Success: true}, nil
 }
 
-func (s *service) SendMessage(ctx context.Context, req *SendMessageReq) (*SendMessageRes, error) {
-	conn, err := grpc.Dial(s.NodeAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return nil, fmt.Errorf("dial error: %w", err)
+func (s *Service) SendMessage(ctx context.Context, req *SendMessageReq) (*SendMessageRes, error) {
+	if req.Nickname != "" {
+		entry, err := s.entryByNickname(ctx, req.Nickname)
+		if err != nil {
+			return nil, err
+		}
+		req.Pubkey, req.IsContact = entry.PublicKey, true
 	}
 
-	decodedPubkey, err := base64.StdEncoding.DecodeString(req.Pubkey)
+	group, err := s.groupInfo(ctx, req.Pubkey, req.IsContact)
 	if err != nil {
 		return nil, err
 	}
 
-	client := protocoltypes.NewProtocolServiceClient(conn)
-	group := &protocoltypes.GroupInfo_Reply{}
-
-	if !req.IsContact {
-		group, err = client.GroupInfo(ctx, &protocoltypes.GroupInfo_Request{
-			GroupPK: decodedPubkey,
-		})
-	} else {
-		group, err = client.GroupInfo(ctx, &protocoltypes.GroupInfo_Request{
-			ContactPK: decodedPubkey,
-		})
+	if s.adminPolicy != nil && s.adminPolicy.Announcements {
+		settings, err := s.groupSettings(ctx, group)
 		if err != nil {
-			return nil, fmt.Errorf("contact group info error: %w", err)
+			return nil, err
+		}
+		if settings.AnnouncementOnly {
+			if err := s.requireAdmin(ctx, group); err != nil {
+				return nil, err
+			}
 		}
 	}
-	//_, err = client.ActivateGroup(context.Background(), &protocoltypes.ActivateGroup_Request{
-	//	GroupPK: group.Group.PublicKey,
-	//})
-	//if err != nil {
-	//	return nil, fmt.Errorf("activate group error: %w", err)
-	//}
 
-	_, err = client.AppMessageSend(ctx, &protocoltypes.AppMessageSend_Request{
+	var payload []byte
+	switch req.Format {
+	case MessageFormat_MESSAGE_FORMAT_MESSENGER:
+		payload, err = encodeAppMessage(req, time.Now())
+	default:
+		payload, err = encodePayload(req)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("encode error: %w", err)
+	}
+
+	sent, err := s.client.AppMessageSend(ctx, &protocoltypes.AppMessageSend_Request{
 		GroupPK: group.Group.PublicKey,
-		Payload: []byte(req.Message),
+		Payload: payload,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("send message error: %w", err)
 	}
-	return &SendMessageRes{Success: true}, nil
-}
 
-func (s *service) ListMessages(req *ListMessagesReq, stream MessengerSvc_ListMessagesServer) error {
-	ctx := stream.Context()
-	conn, err := grpc.Dial(s.NodeAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	id, err := cidString(sent.CID)
 	if err != nil {
-		return fmt.Errorf("dial error: %w", err)
+		return nil, fmt.Errorf("message id error: %w", err)
 	}
 
-	decodedPubkey, err := base64.StdEncoding.DecodeString(req.Pubkey)
+	return &SendMessageRes{Success: true, Id: id}, nil
+}
+
+func (s *Service) ListMessages(req *ListMessagesReq, stream MessengerSvc_ListMessagesServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	group, err := s.groupInfo(ctx, req.Pubkey, req.IsContact)
 	if err != nil {
-		return fmt.Errorf("decode error: %w", err)
+		return err
 	}
 
-	client := protocoltypes.NewProtocolServiceClient(conn)
-	group := &protocoltypes.GroupInfo_Reply{}
-
-	if !req.IsContact {
-		group, err = client.GroupInfo(ctx, &protocoltypes.GroupInfo_Request{
-			GroupPK: decodedPubkey,
-		})
-	} else {
-		group, err = client.GroupInfo(ctx, &protocoltypes.GroupInfo_Request{
-			ContactPK: decodedPubkey,
-		})
-		if err != nil {
-			return fmt.Errorf("contact group info error: %w", err)
+	listReq := &protocoltypes.GroupMessageList_Request{
+		GroupPK:      group.Group.PublicKey,
+		UntilNow:     true,
+		ReverseOrder: req.Order == ListOrder_LIST_ORDER_NEWEST_FIRST,
+	}
+	if req.SinceId != "" {
+		if listReq.SinceID, err = parseCID(req.SinceId); err != nil {
+			return fmt.Errorf("since id error: %w", err)
 		}
 	}
 
-	if err != nil {
-		return fmt.Errorf("group info error: %w", err)
+	if req.UntilId != "" {
+		if listReq.UntilID, err = parseCID(req.UntilId); err != nil {
+			return fmt.Errorf("until id error: %w", err)
+		}
 	}
-	list, err := client.GroupMessageList(context.Background(), &protocoltypes.GroupMessageList_Request{
-		GroupPK:      group.Group.PublicKey,
-		UntilNow:     true,
-		ReverseOrder: true,
-	})
+
+	list, err := s.client.GroupMessageList(ctx, listReq)
 	if err != nil {
 		return err
 	}
 
-	for {
+	var sent uint32
+	for req.Limit == 0 || sent < req.Limit {
 		msg, err := list.Recv()
 		if err == io.EOF {
 			return nil
@@ -268,59 +350,120 @@ func (s *service) ListMessages(req *ListMessagesReq, stream MessengerSvc_ListMes
 			return fmt.Errorf("recv error: %w", err)
 		}
 
-		var id = "invalid"
-		//if len(msg.Headers.DevicePK) > 6 {
-		//	id = string(msg.Headers.DevicePK)[:5]
-		//}
+		id := msg.EventContext.GetID()
+		if bytes.Equal(id, listReq.SinceID) || bytes.Equal(id, listReq.UntilID) {
+			continue
+		}
+		if req.ExcludeOwn && bytes.Equal(msg.Headers.GetDevicePK(), group.DevicePK) {
+			continue
+		}
 
-		fmt.Println(string(msg.Headers.DevicePK))
-		err = stream.Send(&ListMessagesRes{
-			Id:      id,
-			Message: string(msg.GetMessage()), // doesn't work with messenger layer cause non-utf8 chars are not supported
-		})
+		res, err := s.messageRes(ctx, group.Group.PublicKey, msg, req.Format)
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(res)
 		if err != nil {
 			return fmt.Errorf("send error: %w", err)
 		}
+		sent++
 	}
+
+	return nil
 }
 
-func (s *service) CreateGroup(ctx context.Context, req *CreateGroupReq) (*CreateGroupRes, error) {
-	conn, err := grpc.Dial(s.NodeAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func (s *Service) SubscribeMessages(req *SubscribeMessagesReq, stream MessengerSvc_SubscribeMessagesServer) error {
+	group, err := s.groupInfo(stream.Context(), req.Pubkey, req.IsContact)
 	if err != nil {
-		return nil, fmt.Errorf("dial error: %w", err)
+		return err
+	}
+
+	// the subscription ends when the group is left
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	defer s.subs.add(group.Group.PublicKey, cancel)()
+
+	listReq := &protocoltypes.GroupMessageList_Request{
+		GroupPK:  group.Group.PublicKey,
+		SinceNow: !req.ReplayHistory && req.SinceId == "",
 	}
 
-	client := protocoltypes.NewProtocolServiceClient(conn)
-	gpk, err := client.MultiMemberGroupCreate(ctx, &protocoltypes.MultiMemberGroupCreate_Request{})
+	var sinceID []byte
+	if req.SinceId != "" {
+		sinceID, err = parseCID(req.SinceId)
+		if err != nil {
+			return fmt.Errorf("since id error: %w", err)
+		}
+		listReq.SinceID = sinceID
+	}
+
+	list, err := s.client.GroupMessageList(ctx, listReq)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := list.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("recv error: %w", err)
+		}
+
+		// the message given as starting point was already seen by the client
+		if sinceID != nil && bytes.Equal(msg.EventContext.GetID(), sinceID) {
+			continue
+		}
+
+		res, err := s.messageRes(ctx, group.Group.PublicKey, msg, req.Format)
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(res)
+		if err != nil {
+			return fmt.Errorf("send error: %w", err)
+		}
+	}
+}
+
+func (s *Service) CreateGroup(ctx context.Context, req *CreateGroupReq) (*CreateGroupRes, error) {
+	gpk, err := s.client.MultiMemberGroupCreate(ctx, &protocoltypes.MultiMemberGroupCreate_Request{})
 	if err != nil {
 		return nil, fmt.Errorf("create g error: %w", err)
 	}
 
 	{
-		_, err := client.ActivateGroup(ctx, &protocoltypes.ActivateGroup_Request{
+		_, err := s.client.ActivateGroup(ctx, &protocoltypes.ActivateGroup_Request{
 			GroupPK: gpk.GroupPK,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("activate group error: %w", err)
 		}
+		if err := s.setGroupActive(ctx, gpk.GroupPK, true); err != nil {
+			return nil, err
+		}
 	}
 
-	g, err := client.MultiMemberGroupInvitationCreate(ctx, &protocoltypes.MultiMemberGroupInvitationCreate_Request{
-		GroupPK: gpk.GroupPK,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("create invite error: %w", err)
+	if req.Name != "" || req.Description != "" || len(req.Avatar) != 0 {
+		err := s.sendAppMetadata(ctx, gpk.GroupPK, &AppMetadata{Event: &AppMetadata_GroupProfile{GroupProfile: &GroupProfile{
+			Name:        req.Name,
+			Description: req.Description,
+			Avatar:      req.Avatar,
+		}}})
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	group := g.Group
-
-	inv, err := group.Marshal()
+	b64Inv, err := s.groupInvitation(ctx, gpk.GroupPK)
 	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
+		return nil, err
 	}
 
-	b64Inv := base64.StdEncoding.EncodeToString(inv)
-	b64Gpk := base64.StdEncoding.EncodeToString(group.PublicKey)
+	b64Gpk := base64.StdEncoding.EncodeToString(gpk.GroupPK)
 
 	return &CreateGroupRes{
 		GroupPk:         b64Gpk,
@@ -328,12 +471,24 @@ func (s *service) CreateGroup(ctx context.Context, req *CreateGroupReq) (*Create
 	}, nil
 }
 
-func (s *service) JoinGroup(ctx context.Context, req *JoinGroupReq) (*JoinGroupRes, error) {
-	conn, err := grpc.Dial(s.NodeAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// groupInvitation returns a base64 invitation to the group.
+func (s *Service) groupInvitation(ctx context.Context, groupPK []byte) (string, error) {
+	g, err := s.client.MultiMemberGroupInvitationCreate(ctx, &protocoltypes.MultiMemberGroupInvitationCreate_Request{
+		GroupPK: groupPK,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("dial error: %w", err)
+		return "", fmt.Errorf("create invite error: %w", err)
+	}
+
+	inv, err := g.Group.Marshal()
+	if err != nil {
+		return "", fmt.Errorf("marshal error: %w", err)
 	}
 
+	return base64.StdEncoding.EncodeToString(inv), nil
+}
+
+func (s *Service) JoinGroup(ctx context.Context, req *JoinGroupReq) (*JoinGroupRes, error) {
 	decodedInv, err := base64.StdEncoding.DecodeString(req.GroupInvitation)
 	if err != nil {
 		return nil, fmt.Errorf("decode error: %w", err)
@@ -345,20 +500,87 @@ func (s *service) JoinGroup(ctx context.Context, req *JoinGroupReq) (*JoinGroupR
 		return nil, fmt.Errorf("unmarshal error: %w", err)
 	}
 
-	client := protocoltypes.NewProtocolServiceClient(conn)
-	_, err = client.MultiMemberGroupJoin(ctx, &protocoltypes.MultiMemberGroupJoin_Request{
+	_, err = s.client.MultiMemberGroupJoin(ctx, &protocoltypes.MultiMemberGroupJoin_Request{
 		Group: group,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("join error: %w", err)
 	}
 
-	_, err = client.ActivateGroup(ctx, &protocoltypes.ActivateGroup_Request{
+	_, err = s.client.ActivateGroup(ctx, &protocoltypes.ActivateGroup_Request{
 		GroupPK: group.PublicKey,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("activate group error: %w", err)
 	}
+	if err := s.setGroupActive(ctx, group.PublicKey, true); err != nil {
+		return nil, err
+	}
+
+	return &JoinGroupRes{
+		Success: true,
+		GroupPk: base64.StdEncoding.EncodeToString(group.PublicKey),
+	}, nil
+}
+
+// groupInfo returns the group identified by pubkey, which is the key of a
+// contact if isContact is set.
+func (s *Service) groupInfo(ctx context.Context, pubkey string, isContact bool) (*protocoltypes.GroupInfo_Reply, error) {
+	decodedPubkey, err := base64.StdEncoding.DecodeString(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+
+	if isContact {
+		group, err := s.client.GroupInfo(ctx, &protocoltypes.GroupInfo_Request{
+			ContactPK: decodedPubkey,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("contact group info error: %w", err)
+		}
+		return group, nil
+	}
+
+	group, err := s.client.GroupInfo(ctx, &protocoltypes.GroupInfo_Request{
+		GroupPK: decodedPubkey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("group info error: %w", err)
+	}
+	return group, nil
+}
+
+// messageRes returns msg decoded with format, resolving the member that sent it.
+func (s *Service) messageRes(ctx context.Context, groupPK []byte, msg *protocoltypes.GroupMessageEvent, format MessageFormat) (*ListMessagesRes, error) {
+	id, err := cidString(msg.EventContext.GetID())
+	if err != nil {
+		return nil, fmt.Errorf("message id error: %w", err)
+	}
+
+	parentIDs := make([]string, 0, len(msg.EventContext.GetParentIDs()))
+	for _, parent := range msg.EventContext.GetParentIDs() {
+		parentID, err := cidString(parent)
+		if err != nil {
+			return nil, fmt.Errorf("parent id error: %w", err)
+		}
+		parentIDs = append(parentIDs, parentID)
+	}
+
+	memberPK, err := s.members.memberOf(ctx, s.client, groupPK, msg.Headers.GetDevicePK())
+	if err != nil {
+		return nil, fmt.Errorf("member error: %w", err)
+	}
+
+	res := &ListMessagesRes{
+		Id:        id,
+		ParentIds: parentIDs,
+		DevicePk:  base64.StdEncoding.EncodeToString(msg.Headers.GetDevicePK()),
+		MemberPk:  base64.StdEncoding.EncodeToString(memberPK),
+	}
+	if format != MessageFormat_MESSAGE_FORMAT_MESSENGER || !decodeAppMessage(msg.GetMessage(), res) {
+		res.Payload, res.ContentType = decodePayload(msg.GetMessage())
+		res.Message, res.ValidUtf8 = textOf(res.Payload)
+	}
 
-	return &JoinGroupRes{Success: true}, nil
+	return res, nil
 }
@@ -20,6 +20,526 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OutgoingRequestStatus int32
+
+const (
+	// the request was sent but its status could not be resolved.
+	OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_UNKNOWN OutgoingRequestStatus = 0
+	// the request waits for the contact to be reachable on its rendezvous point.
+	OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_ENQUEUED OutgoingRequestStatus = 1
+	OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_SENT     OutgoingRequestStatus = 2
+	// the contact joined the contact group, messages can be exchanged.
+	OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_ACCEPTED OutgoingRequestStatus = 3
+)
+
+// Enum value maps for OutgoingRequestStatus.
+var (
+	OutgoingRequestStatus_name = map[int32]string{
+		0: "OUTGOING_REQUEST_STATUS_UNKNOWN",
+		1: "OUTGOING_REQUEST_STATUS_ENQUEUED",
+		2: "OUTGOING_REQUEST_STATUS_SENT",
+		3: "OUTGOING_REQUEST_STATUS_ACCEPTED",
+	}
+	OutgoingRequestStatus_value = map[string]int32{
+		"OUTGOING_REQUEST_STATUS_UNKNOWN":  0,
+		"OUTGOING_REQUEST_STATUS_ENQUEUED": 1,
+		"OUTGOING_REQUEST_STATUS_SENT":     2,
+		"OUTGOING_REQUEST_STATUS_ACCEPTED": 3,
+	}
+)
+
+func (x OutgoingRequestStatus) Enum() *OutgoingRequestStatus {
+	p := new(OutgoingRequestStatus)
+	*p = x
+	return p
+}
+
+func (x OutgoingRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutgoingRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[0].Descriptor()
+}
+
+func (OutgoingRequestStatus) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[0]
+}
+
+func (x OutgoingRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutgoingRequestStatus.Descriptor instead.
+func (OutgoingRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{0}
+}
+
+type ContactRequestEventType int32
+
+const (
+	ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_UNDEFINED ContactRequestEventType = 0
+	ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_RECEIVED  ContactRequestEventType = 1
+	ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_ACCEPTED  ContactRequestEventType = 2
+	ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_DISCARDED ContactRequestEventType = 3
+)
+
+// Enum value maps for ContactRequestEventType.
+var (
+	ContactRequestEventType_name = map[int32]string{
+		0: "CONTACT_REQUEST_EVENT_TYPE_UNDEFINED",
+		1: "CONTACT_REQUEST_EVENT_TYPE_RECEIVED",
+		2: "CONTACT_REQUEST_EVENT_TYPE_ACCEPTED",
+		3: "CONTACT_REQUEST_EVENT_TYPE_DISCARDED",
+	}
+	ContactRequestEventType_value = map[string]int32{
+		"CONTACT_REQUEST_EVENT_TYPE_UNDEFINED": 0,
+		"CONTACT_REQUEST_EVENT_TYPE_RECEIVED":  1,
+		"CONTACT_REQUEST_EVENT_TYPE_ACCEPTED":  2,
+		"CONTACT_REQUEST_EVENT_TYPE_DISCARDED": 3,
+	}
+)
+
+func (x ContactRequestEventType) Enum() *ContactRequestEventType {
+	p := new(ContactRequestEventType)
+	*p = x
+	return p
+}
+
+func (x ContactRequestEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContactRequestEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[1].Descriptor()
+}
+
+func (ContactRequestEventType) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[1]
+}
+
+func (x ContactRequestEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContactRequestEventType.Descriptor instead.
+func (ContactRequestEventType) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{1}
+}
+
+type ContactAction int32
+
+const (
+	// held requests are left pending for an operator.
+	ContactAction_CONTACT_ACTION_HOLD    ContactAction = 0
+	ContactAction_CONTACT_ACTION_ACCEPT  ContactAction = 1
+	ContactAction_CONTACT_ACTION_DISCARD ContactAction = 2
+)
+
+// Enum value maps for ContactAction.
+var (
+	ContactAction_name = map[int32]string{
+		0: "CONTACT_ACTION_HOLD",
+		1: "CONTACT_ACTION_ACCEPT",
+		2: "CONTACT_ACTION_DISCARD",
+	}
+	ContactAction_value = map[string]int32{
+		"CONTACT_ACTION_HOLD":    0,
+		"CONTACT_ACTION_ACCEPT":  1,
+		"CONTACT_ACTION_DISCARD": 2,
+	}
+)
+
+func (x ContactAction) Enum() *ContactAction {
+	p := new(ContactAction)
+	*p = x
+	return p
+}
+
+func (x ContactAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContactAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[2].Descriptor()
+}
+
+func (ContactAction) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[2]
+}
+
+func (x ContactAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContactAction.Descriptor instead.
+func (ContactAction) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{2}
+}
+
+type ContactState int32
+
+const (
+	ContactState_CONTACT_STATE_UNDEFINED         ContactState = 0
+	ContactState_CONTACT_STATE_OUTGOING_ENQUEUED ContactState = 1
+	ContactState_CONTACT_STATE_OUTGOING_SENT     ContactState = 2
+	ContactState_CONTACT_STATE_INCOMING_RECEIVED ContactState = 3
+	ContactState_CONTACT_STATE_ACCEPTED          ContactState = 4
+	ContactState_CONTACT_STATE_DISCARDED         ContactState = 5
+)
+
+// Enum value maps for ContactState.
+var (
+	ContactState_name = map[int32]string{
+		0: "CONTACT_STATE_UNDEFINED",
+		1: "CONTACT_STATE_OUTGOING_ENQUEUED",
+		2: "CONTACT_STATE_OUTGOING_SENT",
+		3: "CONTACT_STATE_INCOMING_RECEIVED",
+		4: "CONTACT_STATE_ACCEPTED",
+		5: "CONTACT_STATE_DISCARDED",
+	}
+	ContactState_value = map[string]int32{
+		"CONTACT_STATE_UNDEFINED":         0,
+		"CONTACT_STATE_OUTGOING_ENQUEUED": 1,
+		"CONTACT_STATE_OUTGOING_SENT":     2,
+		"CONTACT_STATE_INCOMING_RECEIVED": 3,
+		"CONTACT_STATE_ACCEPTED":          4,
+		"CONTACT_STATE_DISCARDED":         5,
+	}
+)
+
+func (x ContactState) Enum() *ContactState {
+	p := new(ContactState)
+	*p = x
+	return p
+}
+
+func (x ContactState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContactState) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[3].Descriptor()
+}
+
+func (ContactState) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[3]
+}
+
+func (x ContactState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContactState.Descriptor instead.
+func (ContactState) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{3}
+}
+
+type ContactDirection int32
+
+const (
+	ContactDirection_CONTACT_DIRECTION_UNDEFINED ContactDirection = 0
+	ContactDirection_CONTACT_DIRECTION_INCOMING  ContactDirection = 1
+	ContactDirection_CONTACT_DIRECTION_OUTGOING  ContactDirection = 2
+)
+
+// Enum value maps for ContactDirection.
+var (
+	ContactDirection_name = map[int32]string{
+		0: "CONTACT_DIRECTION_UNDEFINED",
+		1: "CONTACT_DIRECTION_INCOMING",
+		2: "CONTACT_DIRECTION_OUTGOING",
+	}
+	ContactDirection_value = map[string]int32{
+		"CONTACT_DIRECTION_UNDEFINED": 0,
+		"CONTACT_DIRECTION_INCOMING":  1,
+		"CONTACT_DIRECTION_OUTGOING":  2,
+	}
+)
+
+func (x ContactDirection) Enum() *ContactDirection {
+	p := new(ContactDirection)
+	*p = x
+	return p
+}
+
+func (x ContactDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContactDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[4].Descriptor()
+}
+
+func (ContactDirection) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[4]
+}
+
+func (x ContactDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContactDirection.Descriptor instead.
+func (ContactDirection) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{4}
+}
+
+type ListOrder int32
+
+const (
+	ListOrder_LIST_ORDER_NEWEST_FIRST ListOrder = 0
+	ListOrder_LIST_ORDER_OLDEST_FIRST ListOrder = 1
+)
+
+// Enum value maps for ListOrder.
+var (
+	ListOrder_name = map[int32]string{
+		0: "LIST_ORDER_NEWEST_FIRST",
+		1: "LIST_ORDER_OLDEST_FIRST",
+	}
+	ListOrder_value = map[string]int32{
+		"LIST_ORDER_NEWEST_FIRST": 0,
+		"LIST_ORDER_OLDEST_FIRST": 1,
+	}
+)
+
+func (x ListOrder) Enum() *ListOrder {
+	p := new(ListOrder)
+	*p = x
+	return p
+}
+
+func (x ListOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[5].Descriptor()
+}
+
+func (ListOrder) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[5]
+}
+
+func (x ListOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListOrder.Descriptor instead.
+func (ListOrder) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{5}
+}
+
+type MessageFormat int32
+
+const (
+	// raw messages carry the payload as is.
+	MessageFormat_MESSAGE_FORMAT_RAW MessageFormat = 0
+	// messenger messages are AppMessage envelopes as used by the Berty Messenger app.
+	MessageFormat_MESSAGE_FORMAT_MESSENGER MessageFormat = 1
+)
+
+// Enum value maps for MessageFormat.
+var (
+	MessageFormat_name = map[int32]string{
+		0: "MESSAGE_FORMAT_RAW",
+		1: "MESSAGE_FORMAT_MESSENGER",
+	}
+	MessageFormat_value = map[string]int32{
+		"MESSAGE_FORMAT_RAW":       0,
+		"MESSAGE_FORMAT_MESSENGER": 1,
+	}
+)
+
+func (x MessageFormat) Enum() *MessageFormat {
+	p := new(MessageFormat)
+	*p = x
+	return p
+}
+
+func (x MessageFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[6].Descriptor()
+}
+
+func (MessageFormat) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[6]
+}
+
+func (x MessageFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageFormat.Descriptor instead.
+func (MessageFormat) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{6}
+}
+
+// AppMessageType is the kind of a messenger format message, the text of the
+// message being its body, invitation link or display name.
+type AppMessageType int32
+
+const (
+	AppMessageType_APP_MESSAGE_TYPE_UNDEFINED        AppMessageType = 0
+	AppMessageType_APP_MESSAGE_TYPE_USER_MESSAGE     AppMessageType = 1
+	AppMessageType_APP_MESSAGE_TYPE_ACKNOWLEDGE      AppMessageType = 2
+	AppMessageType_APP_MESSAGE_TYPE_GROUP_INVITATION AppMessageType = 3
+	AppMessageType_APP_MESSAGE_TYPE_SET_USER_INFO    AppMessageType = 4
+)
+
+// Enum value maps for AppMessageType.
+var (
+	AppMessageType_name = map[int32]string{
+		0: "APP_MESSAGE_TYPE_UNDEFINED",
+		1: "APP_MESSAGE_TYPE_USER_MESSAGE",
+		2: "APP_MESSAGE_TYPE_ACKNOWLEDGE",
+		3: "APP_MESSAGE_TYPE_GROUP_INVITATION",
+		4: "APP_MESSAGE_TYPE_SET_USER_INFO",
+	}
+	AppMessageType_value = map[string]int32{
+		"APP_MESSAGE_TYPE_UNDEFINED":        0,
+		"APP_MESSAGE_TYPE_USER_MESSAGE":     1,
+		"APP_MESSAGE_TYPE_ACKNOWLEDGE":      2,
+		"APP_MESSAGE_TYPE_GROUP_INVITATION": 3,
+		"APP_MESSAGE_TYPE_SET_USER_INFO":    4,
+	}
+)
+
+func (x AppMessageType) Enum() *AppMessageType {
+	p := new(AppMessageType)
+	*p = x
+	return p
+}
+
+func (x AppMessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppMessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[7].Descriptor()
+}
+
+func (AppMessageType) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[7]
+}
+
+func (x AppMessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppMessageType.Descriptor instead.
+func (AppMessageType) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{7}
+}
+
+type GroupMemberEventType int32
+
+const (
+	GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_UNDEFINED     GroupMemberEventType = 0
+	GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_MEMBER_JOINED GroupMemberEventType = 1
+	GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_DEVICE_ADDED  GroupMemberEventType = 2
+	GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_ADMIN_GRANTED GroupMemberEventType = 3
+)
+
+// Enum value maps for GroupMemberEventType.
+var (
+	GroupMemberEventType_name = map[int32]string{
+		0: "GROUP_MEMBER_EVENT_TYPE_UNDEFINED",
+		1: "GROUP_MEMBER_EVENT_TYPE_MEMBER_JOINED",
+		2: "GROUP_MEMBER_EVENT_TYPE_DEVICE_ADDED",
+		3: "GROUP_MEMBER_EVENT_TYPE_ADMIN_GRANTED",
+	}
+	GroupMemberEventType_value = map[string]int32{
+		"GROUP_MEMBER_EVENT_TYPE_UNDEFINED":     0,
+		"GROUP_MEMBER_EVENT_TYPE_MEMBER_JOINED": 1,
+		"GROUP_MEMBER_EVENT_TYPE_DEVICE_ADDED":  2,
+		"GROUP_MEMBER_EVENT_TYPE_ADMIN_GRANTED": 3,
+	}
+)
+
+func (x GroupMemberEventType) Enum() *GroupMemberEventType {
+	p := new(GroupMemberEventType)
+	*p = x
+	return p
+}
+
+func (x GroupMemberEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupMemberEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[8].Descriptor()
+}
+
+func (GroupMemberEventType) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[8]
+}
+
+func (x GroupMemberEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupMemberEventType.Descriptor instead.
+func (GroupMemberEventType) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{8}
+}
+
+// GroupType values match the protocol ones.
+type GroupType int32
+
+const (
+	GroupType_GROUP_TYPE_UNDEFINED    GroupType = 0
+	GroupType_GROUP_TYPE_ACCOUNT      GroupType = 1
+	GroupType_GROUP_TYPE_CONTACT      GroupType = 2
+	GroupType_GROUP_TYPE_MULTI_MEMBER GroupType = 3
+)
+
+// Enum value maps for GroupType.
+var (
+	GroupType_name = map[int32]string{
+		0: "GROUP_TYPE_UNDEFINED",
+		1: "GROUP_TYPE_ACCOUNT",
+		2: "GROUP_TYPE_CONTACT",
+		3: "GROUP_TYPE_MULTI_MEMBER",
+	}
+	GroupType_value = map[string]int32{
+		"GROUP_TYPE_UNDEFINED":    0,
+		"GROUP_TYPE_ACCOUNT":      1,
+		"GROUP_TYPE_CONTACT":      2,
+		"GROUP_TYPE_MULTI_MEMBER": 3,
+	}
+)
+
+func (x GroupType) Enum() *GroupType {
+	p := new(GroupType)
+	*p = x
+	return p
+}
+
+func (x GroupType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupType) Descriptor() protoreflect.EnumDescriptor {
+	return file_messenger_proto_enumTypes[9].Descriptor()
+}
+
+func (GroupType) Type() protoreflect.EnumType {
+	return &file_messenger_proto_enumTypes[9]
+}
+
+func (x GroupType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupType.Descriptor instead.
+func (GroupType) EnumDescriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{9}
+}
+
 type GetContactPubkeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -65,6 +585,8 @@ type GetContactPubkeyRes struct {
 
 	Pubkey  string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	RdvSeed string `protobuf:"bytes,2,opt,name=rdvSeed,proto3" json:"rdvSeed,omitempty"`
+	// enabled is false when incoming contact requests are turned off.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
 func (x *GetContactPubkeyRes) Reset() {
@@ -113,14 +635,25 @@ func (x *GetContactPubkeyRes) GetRdvSeed() string {
 	return ""
 }
 
-type GetContactRequestsReq struct {
+func (x *GetContactPubkeyRes) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ShareContactReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// qr_code renders the web link as a PNG QR code.
+	QrCode bool `protobuf:"varint,2,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
 }
 
-func (x *GetContactRequestsReq) Reset() {
-	*x = GetContactRequestsReq{}
+func (x *ShareContactReq) Reset() {
+	*x = ShareContactReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -128,13 +661,13 @@ func (x *GetContactRequestsReq) Reset() {
 	}
 }
 
-func (x *GetContactRequestsReq) String() string {
+func (x *ShareContactReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactRequestsReq) ProtoMessage() {}
+func (*ShareContactReq) ProtoMessage() {}
 
-func (x *GetContactRequestsReq) ProtoReflect() protoreflect.Message {
+func (x *ShareContactReq) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -146,21 +679,39 @@ func (x *GetContactRequestsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactRequestsReq.ProtoReflect.Descriptor instead.
-func (*GetContactRequestsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShareContactReq.ProtoReflect.Descriptor instead.
+func (*ShareContactReq) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{2}
 }
 
-type GetContactRequestsRes struct {
+func (x *ShareContactReq) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ShareContactReq) GetQrCode() bool {
+	if x != nil {
+		return x.QrCode
+	}
+	return false
+}
+
+type ShareContactRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContactRequests []*GetContactRequestsRes_ContactRequest `protobuf:"bytes,1,rep,name=contact_requests,json=contactRequests,proto3" json:"contact_requests,omitempty"`
+	// link is the berty:// form, opened directly by Berty apps.
+	Link string `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	// web_link is the https://berty.tech/id form, for browsers.
+	WebLink   string `protobuf:"bytes,2,opt,name=web_link,json=webLink,proto3" json:"web_link,omitempty"`
+	QrCodePng []byte `protobuf:"bytes,3,opt,name=qr_code_png,json=qrCodePng,proto3" json:"qr_code_png,omitempty"`
 }
 
-func (x *GetContactRequestsRes) Reset() {
-	*x = GetContactRequestsRes{}
+func (x *ShareContactRes) Reset() {
+	*x = ShareContactRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -168,13 +719,13 @@ func (x *GetContactRequestsRes) Reset() {
 	}
 }
 
-func (x *GetContactRequestsRes) String() string {
+func (x *ShareContactRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactRequestsRes) ProtoMessage() {}
+func (*ShareContactRes) ProtoMessage() {}
 
-func (x *GetContactRequestsRes) ProtoReflect() protoreflect.Message {
+func (x *ShareContactRes) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -186,30 +737,40 @@ func (x *GetContactRequestsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactRequestsRes.ProtoReflect.Descriptor instead.
-func (*GetContactRequestsRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShareContactRes.ProtoReflect.Descriptor instead.
+func (*ShareContactRes) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetContactRequestsRes) GetContactRequests() []*GetContactRequestsRes_ContactRequest {
+func (x *ShareContactRes) GetLink() string {
 	if x != nil {
-		return x.ContactRequests
+		return x.Link
 	}
-	return nil
+	return ""
 }
 
-type SendContactRequestReq struct {
+func (x *ShareContactRes) GetWebLink() string {
+	if x != nil {
+		return x.WebLink
+	}
+	return ""
+}
+
+func (x *ShareContactRes) GetQrCodePng() []byte {
+	if x != nil {
+		return x.QrCodePng
+	}
+	return nil
+}
+
+type EnableContactRequestsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Pubkey  string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	RdvSeed string `protobuf:"bytes,2,opt,name=rdvSeed,proto3" json:"rdvSeed,omitempty"`
-	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *SendContactRequestReq) Reset() {
-	*x = SendContactRequestReq{}
+func (x *EnableContactRequestsReq) Reset() {
+	*x = EnableContactRequestsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -217,13 +778,13 @@ func (x *SendContactRequestReq) Reset() {
 	}
 }
 
-func (x *SendContactRequestReq) String() string {
+func (x *EnableContactRequestsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendContactRequestReq) ProtoMessage() {}
+func (*EnableContactRequestsReq) ProtoMessage() {}
 
-func (x *SendContactRequestReq) ProtoReflect() protoreflect.Message {
+func (x *EnableContactRequestsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -235,42 +796,21 @@ func (x *SendContactRequestReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendContactRequestReq.ProtoReflect.Descriptor instead.
-func (*SendContactRequestReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnableContactRequestsReq.ProtoReflect.Descriptor instead.
+func (*EnableContactRequestsReq) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SendContactRequestReq) GetPubkey() string {
-	if x != nil {
-		return x.Pubkey
-	}
-	return ""
-}
-
-func (x *SendContactRequestReq) GetRdvSeed() string {
-	if x != nil {
-		return x.RdvSeed
-	}
-	return ""
-}
-
-func (x *SendContactRequestReq) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type SendContactRequestRes struct {
+type EnableContactRequestsRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RdvSeed string `protobuf:"bytes,1,opt,name=rdvSeed,proto3" json:"rdvSeed,omitempty"`
 }
 
-func (x *SendContactRequestRes) Reset() {
-	*x = SendContactRequestRes{}
+func (x *EnableContactRequestsRes) Reset() {
+	*x = EnableContactRequestsRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -278,13 +818,13 @@ func (x *SendContactRequestRes) Reset() {
 	}
 }
 
-func (x *SendContactRequestRes) String() string {
+func (x *EnableContactRequestsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendContactRequestRes) ProtoMessage() {}
+func (*EnableContactRequestsRes) ProtoMessage() {}
 
-func (x *SendContactRequestRes) ProtoReflect() protoreflect.Message {
+func (x *EnableContactRequestsRes) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,28 +836,26 @@ func (x *SendContactRequestRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendContactRequestRes.ProtoReflect.Descriptor instead.
-func (*SendContactRequestRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnableContactRequestsRes.ProtoReflect.Descriptor instead.
+func (*EnableContactRequestsRes) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SendContactRequestRes) GetSuccess() bool {
+func (x *EnableContactRequestsRes) GetRdvSeed() string {
 	if x != nil {
-		return x.Success
+		return x.RdvSeed
 	}
-	return false
+	return ""
 }
 
-type AcceptContactRequestReq struct {
+type DisableContactRequestsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 }
 
-func (x *AcceptContactRequestReq) Reset() {
-	*x = AcceptContactRequestReq{}
+func (x *DisableContactRequestsReq) Reset() {
+	*x = DisableContactRequestsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -325,13 +863,13 @@ func (x *AcceptContactRequestReq) Reset() {
 	}
 }
 
-func (x *AcceptContactRequestReq) String() string {
+func (x *DisableContactRequestsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptContactRequestReq) ProtoMessage() {}
+func (*DisableContactRequestsReq) ProtoMessage() {}
 
-func (x *AcceptContactRequestReq) ProtoReflect() protoreflect.Message {
+func (x *DisableContactRequestsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,19 +881,12 @@ func (x *AcceptContactRequestReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptContactRequestReq.ProtoReflect.Descriptor instead.
-func (*AcceptContactRequestReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableContactRequestsReq.ProtoReflect.Descriptor instead.
+func (*DisableContactRequestsReq) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AcceptContactRequestReq) GetPubkey() string {
-	if x != nil {
-		return x.Pubkey
-	}
-	return ""
-}
-
-type AcceptContactRequestRes struct {
+type DisableContactRequestsRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -363,8 +894,8 @@ type AcceptContactRequestRes struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *AcceptContactRequestRes) Reset() {
-	*x = AcceptContactRequestRes{}
+func (x *DisableContactRequestsRes) Reset() {
+	*x = DisableContactRequestsRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -372,13 +903,13 @@ func (x *AcceptContactRequestRes) Reset() {
 	}
 }
 
-func (x *AcceptContactRequestRes) String() string {
+func (x *DisableContactRequestsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptContactRequestRes) ProtoMessage() {}
+func (*DisableContactRequestsRes) ProtoMessage() {}
 
-func (x *AcceptContactRequestRes) ProtoReflect() protoreflect.Message {
+func (x *DisableContactRequestsRes) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -390,30 +921,26 @@ func (x *AcceptContactRequestRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptContactRequestRes.ProtoReflect.Descriptor instead.
-func (*AcceptContactRequestRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableContactRequestsRes.ProtoReflect.Descriptor instead.
+func (*DisableContactRequestsRes) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AcceptContactRequestRes) GetSuccess() bool {
+func (x *DisableContactRequestsRes) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type SendMessageReq struct {
+type ResetContactReferenceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Pubkey    string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	IsContact bool   `protobuf:"varint,3,opt,name=isContact,proto3" json:"isContact,omitempty"`
 }
 
-func (x *SendMessageReq) Reset() {
-	*x = SendMessageReq{}
+func (x *ResetContactReferenceReq) Reset() {
+	*x = ResetContactReferenceReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,13 +948,13 @@ func (x *SendMessageReq) Reset() {
 	}
 }
 
-func (x *SendMessageReq) String() string {
+func (x *ResetContactReferenceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageReq) ProtoMessage() {}
+func (*ResetContactReferenceReq) ProtoMessage() {}
 
-func (x *SendMessageReq) ProtoReflect() protoreflect.Message {
+func (x *ResetContactReferenceReq) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,42 +966,22 @@ func (x *SendMessageReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageReq.ProtoReflect.Descriptor instead.
-func (*SendMessageReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetContactReferenceReq.ProtoReflect.Descriptor instead.
+func (*ResetContactReferenceReq) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SendMessageReq) GetPubkey() string {
-	if x != nil {
-		return x.Pubkey
-	}
-	return ""
-}
-
-func (x *SendMessageReq) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *SendMessageReq) GetIsContact() bool {
-	if x != nil {
-		return x.IsContact
-	}
-	return false
-}
-
-type SendMessageRes struct {
+type ResetContactReferenceRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// rdvSeed replaces the previous seed, requests sent to the old one are lost.
+	RdvSeed string `protobuf:"bytes,1,opt,name=rdvSeed,proto3" json:"rdvSeed,omitempty"`
 }
 
-func (x *SendMessageRes) Reset() {
-	*x = SendMessageRes{}
+func (x *ResetContactReferenceRes) Reset() {
+	*x = ResetContactReferenceRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,13 +989,13 @@ func (x *SendMessageRes) Reset() {
 	}
 }
 
-func (x *SendMessageRes) String() string {
+func (x *ResetContactReferenceRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageRes) ProtoMessage() {}
+func (*ResetContactReferenceRes) ProtoMessage() {}
 
-func (x *SendMessageRes) ProtoReflect() protoreflect.Message {
+func (x *ResetContactReferenceRes) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -500,29 +1007,26 @@ func (x *SendMessageRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageRes.ProtoReflect.Descriptor instead.
-func (*SendMessageRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetContactReferenceRes.ProtoReflect.Descriptor instead.
+func (*ResetContactReferenceRes) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SendMessageRes) GetSuccess() bool {
+func (x *ResetContactReferenceRes) GetRdvSeed() string {
 	if x != nil {
-		return x.Success
+		return x.RdvSeed
 	}
-	return false
+	return ""
 }
 
-type ListMessagesReq struct {
+type GetContactRequestsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Pubkey    string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	IsContact bool   `protobuf:"varint,2,opt,name=isContact,proto3" json:"isContact,omitempty"`
 }
 
-func (x *ListMessagesReq) Reset() {
-	*x = ListMessagesReq{}
+func (x *GetContactRequestsReq) Reset() {
+	*x = GetContactRequestsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -530,13 +1034,13 @@ func (x *ListMessagesReq) Reset() {
 	}
 }
 
-func (x *ListMessagesReq) String() string {
+func (x *GetContactRequestsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMessagesReq) ProtoMessage() {}
+func (*GetContactRequestsReq) ProtoMessage() {}
 
-func (x *ListMessagesReq) ProtoReflect() protoreflect.Message {
+func (x *GetContactRequestsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,36 +1052,21 @@ func (x *ListMessagesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMessagesReq.ProtoReflect.Descriptor instead.
-func (*ListMessagesReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContactRequestsReq.ProtoReflect.Descriptor instead.
+func (*GetContactRequestsReq) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListMessagesReq) GetPubkey() string {
-	if x != nil {
-		return x.Pubkey
-	}
-	return ""
-}
-
-func (x *ListMessagesReq) GetIsContact() bool {
-	if x != nil {
-		return x.IsContact
-	}
-	return false
-}
-
-type ListMessagesRes struct {
+type GetContactRequestsRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ContactRequests []*GetContactRequestsRes_ContactRequest `protobuf:"bytes,1,rep,name=contact_requests,json=contactRequests,proto3" json:"contact_requests,omitempty"`
 }
 
-func (x *ListMessagesRes) Reset() {
-	*x = ListMessagesRes{}
+func (x *GetContactRequestsRes) Reset() {
+	*x = GetContactRequestsRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,13 +1074,13 @@ func (x *ListMessagesRes) Reset() {
 	}
 }
 
-func (x *ListMessagesRes) String() string {
+func (x *GetContactRequestsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMessagesRes) ProtoMessage() {}
+func (*GetContactRequestsRes) ProtoMessage() {}
 
-func (x *ListMessagesRes) ProtoReflect() protoreflect.Message {
+func (x *GetContactRequestsRes) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,33 +1092,35 @@ func (x *ListMessagesRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMessagesRes.ProtoReflect.Descriptor instead.
-func (*ListMessagesRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContactRequestsRes.ProtoReflect.Descriptor instead.
+func (*GetContactRequestsRes) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListMessagesRes) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ListMessagesRes) GetMessage() string {
+func (x *GetContactRequestsRes) GetContactRequests() []*GetContactRequestsRes_ContactRequest {
 	if x != nil {
-		return x.Message
+		return x.ContactRequests
 	}
-	return ""
+	return nil
 }
 
-type CreateGroupReq struct {
+type SendContactRequestReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Pubkey  string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	RdvSeed string `protobuf:"bytes,2,opt,name=rdvSeed,proto3" json:"rdvSeed,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// link is a contact link as returned by ShareContact, used instead of
+	// pubkey and rdvSeed.
+	Link string `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	// card is shown to the contact. Its display name defaults to name.
+	Card *ContactCard `protobuf:"bytes,5,opt,name=card,proto3" json:"card,omitempty"`
 }
 
-func (x *CreateGroupReq) Reset() {
-	*x = CreateGroupReq{}
+func (x *SendContactRequestReq) Reset() {
+	*x = SendContactRequestReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -637,13 +1128,13 @@ func (x *CreateGroupReq) Reset() {
 	}
 }
 
-func (x *CreateGroupReq) String() string {
+func (x *SendContactRequestReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupReq) ProtoMessage() {}
+func (*SendContactRequestReq) ProtoMessage() {}
 
-func (x *CreateGroupReq) ProtoReflect() protoreflect.Message {
+func (x *SendContactRequestReq) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,22 +1146,57 @@ func (x *CreateGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupReq.ProtoReflect.Descriptor instead.
-func (*CreateGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendContactRequestReq.ProtoReflect.Descriptor instead.
+func (*SendContactRequestReq) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{12}
 }
 
-type CreateGroupRes struct {
+func (x *SendContactRequestReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *SendContactRequestReq) GetRdvSeed() string {
+	if x != nil {
+		return x.RdvSeed
+	}
+	return ""
+}
+
+func (x *SendContactRequestReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SendContactRequestReq) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *SendContactRequestReq) GetCard() *ContactCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type SendContactRequestRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupPk         string `protobuf:"bytes,1,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
-	GroupInvitation string `protobuf:"bytes,2,opt,name=groupInvitation,proto3" json:"groupInvitation,omitempty"`
+	Success bool                  `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status  OutgoingRequestStatus `protobuf:"varint,2,opt,name=status,proto3,enum=OutgoingRequestStatus" json:"status,omitempty"`
 }
 
-func (x *CreateGroupRes) Reset() {
-	*x = CreateGroupRes{}
+func (x *SendContactRequestRes) Reset() {
+	*x = SendContactRequestRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messenger_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -678,13 +1204,13 @@ func (x *CreateGroupRes) Reset() {
 	}
 }
 
-func (x *CreateGroupRes) String() string {
+func (x *SendContactRequestRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRes) ProtoMessage() {}
+func (*SendContactRequestRes) ProtoMessage() {}
 
-func (x *CreateGroupRes) ProtoReflect() protoreflect.Message {
+func (x *SendContactRequestRes) ProtoReflect() protoreflect.Message {
 	mi := &file_messenger_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -696,353 +1222,5846 @@ func (x *CreateGroupRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRes.ProtoReflect.Descriptor instead.
-func (*CreateGroupRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendContactRequestRes.ProtoReflect.Descriptor instead.
+func (*SendContactRequestRes) Descriptor() ([]byte, []int) {
 	return file_messenger_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateGroupRes) GetGroupPk() string {
+func (x *SendContactRequestRes) GetSuccess() bool {
 	if x != nil {
-		return x.GroupPk
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendContactRequestRes) GetStatus() OutgoingRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_UNKNOWN
+}
+
+type ListOutgoingContactRequestsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListOutgoingContactRequestsReq) Reset() {
+	*x = ListOutgoingContactRequestsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutgoingContactRequestsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingContactRequestsReq) ProtoMessage() {}
+
+func (x *ListOutgoingContactRequestsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingContactRequestsReq.ProtoReflect.Descriptor instead.
+func (*ListOutgoingContactRequestsReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{14}
+}
+
+type ListOutgoingContactRequestsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*ListOutgoingContactRequestsRes_OutgoingContactRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListOutgoingContactRequestsRes) Reset() {
+	*x = ListOutgoingContactRequestsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutgoingContactRequestsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingContactRequestsRes) ProtoMessage() {}
+
+func (x *ListOutgoingContactRequestsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingContactRequestsRes.ProtoReflect.Descriptor instead.
+func (*ListOutgoingContactRequestsRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOutgoingContactRequestsRes) GetRequests() []*ListOutgoingContactRequestsRes_OutgoingContactRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type AcceptContactRequestReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (x *AcceptContactRequestReq) Reset() {
+	*x = AcceptContactRequestReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptContactRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptContactRequestReq) ProtoMessage() {}
+
+func (x *AcceptContactRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptContactRequestReq.ProtoReflect.Descriptor instead.
+func (*AcceptContactRequestReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AcceptContactRequestReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
 	}
 	return ""
 }
 
-func (x *CreateGroupRes) GetGroupInvitation() string {
+type AcceptContactRequestRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *AcceptContactRequestRes) Reset() {
+	*x = AcceptContactRequestRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptContactRequestRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptContactRequestRes) ProtoMessage() {}
+
+func (x *AcceptContactRequestRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptContactRequestRes.ProtoReflect.Descriptor instead.
+func (*AcceptContactRequestRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AcceptContactRequestRes) GetSuccess() bool {
 	if x != nil {
-		return x.GroupInvitation
+		return x.Success
+	}
+	return false
+}
+
+type DiscardContactRequestReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (x *DiscardContactRequestReq) Reset() {
+	*x = DiscardContactRequestReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardContactRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardContactRequestReq) ProtoMessage() {}
+
+func (x *DiscardContactRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardContactRequestReq.ProtoReflect.Descriptor instead.
+func (*DiscardContactRequestReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DiscardContactRequestReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
 	}
 	return ""
 }
 
-type JoinGroupReq struct {
+type DiscardContactRequestRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupInvitation string `protobuf:"bytes,1,opt,name=groupInvitation,proto3" json:"groupInvitation,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *JoinGroupReq) Reset() {
-	*x = JoinGroupReq{}
+func (x *DiscardContactRequestRes) Reset() {
+	*x = DiscardContactRequestRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_messenger_proto_msgTypes[14]
+		mi := &file_messenger_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JoinGroupReq) String() string {
+func (x *DiscardContactRequestRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinGroupReq) ProtoMessage() {}
+func (*DiscardContactRequestRes) ProtoMessage() {}
 
-func (x *JoinGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_messenger_proto_msgTypes[14]
+func (x *DiscardContactRequestRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardContactRequestRes.ProtoReflect.Descriptor instead.
+func (*DiscardContactRequestRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DiscardContactRequestRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type BlockContactReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (x *BlockContactReq) Reset() {
+	*x = BlockContactReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockContactReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockContactReq) ProtoMessage() {}
+
+func (x *BlockContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use JoinGroupReq.ProtoReflect.Descriptor instead.
-func (*JoinGroupReq) Descriptor() ([]byte, []int) {
-	return file_messenger_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *JoinGroupReq) GetGroupInvitation() string {
-	if x != nil {
-		return x.GroupInvitation
-	}
-	return ""
-}
-
-type JoinGroupRes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	GroupPk string `protobuf:"bytes,2,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
-}
-
-func (x *JoinGroupRes) Reset() {
-	*x = JoinGroupRes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_messenger_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *JoinGroupRes) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*JoinGroupRes) ProtoMessage() {}
-
-func (x *JoinGroupRes) ProtoReflect() protoreflect.Message {
-	mi := &file_messenger_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockContactReq.ProtoReflect.Descriptor instead.
+func (*BlockContactReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BlockContactReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+type BlockContactRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *BlockContactRes) Reset() {
+	*x = BlockContactRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockContactRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockContactRes) ProtoMessage() {}
+
+func (x *BlockContactRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockContactRes.ProtoReflect.Descriptor instead.
+func (*BlockContactRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BlockContactRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnblockContactReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (x *UnblockContactReq) Reset() {
+	*x = UnblockContactReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockContactReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockContactReq) ProtoMessage() {}
+
+func (x *UnblockContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockContactReq.ProtoReflect.Descriptor instead.
+func (*UnblockContactReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnblockContactReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+type UnblockContactRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnblockContactRes) Reset() {
+	*x = UnblockContactRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockContactRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockContactRes) ProtoMessage() {}
+
+func (x *UnblockContactRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockContactRes.ProtoReflect.Descriptor instead.
+func (*UnblockContactRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnblockContactRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSafetyNumberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (x *GetSafetyNumberReq) Reset() {
+	*x = GetSafetyNumberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSafetyNumberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSafetyNumberReq) ProtoMessage() {}
+
+func (x *GetSafetyNumberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSafetyNumberReq.ProtoReflect.Descriptor instead.
+func (*GetSafetyNumberReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetSafetyNumberReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+type GetSafetyNumberRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// safety_number is the same on both sides, as 12 groups of 5 digits.
+	SafetyNumber string `protobuf:"bytes,1,opt,name=safety_number,json=safetyNumber,proto3" json:"safety_number,omitempty"`
+	Verified     bool   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (x *GetSafetyNumberRes) Reset() {
+	*x = GetSafetyNumberRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSafetyNumberRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSafetyNumberRes) ProtoMessage() {}
+
+func (x *GetSafetyNumberRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSafetyNumberRes.ProtoReflect.Descriptor instead.
+func (*GetSafetyNumberRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetSafetyNumberRes) GetSafetyNumber() string {
+	if x != nil {
+		return x.SafetyNumber
+	}
+	return ""
+}
+
+func (x *GetSafetyNumberRes) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type SetContactVerifiedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey   string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Verified bool   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (x *SetContactVerifiedReq) Reset() {
+	*x = SetContactVerifiedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContactVerifiedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactVerifiedReq) ProtoMessage() {}
+
+func (x *SetContactVerifiedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactVerifiedReq.ProtoReflect.Descriptor instead.
+func (*SetContactVerifiedReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetContactVerifiedReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *SetContactVerifiedReq) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type SetContactVerifiedRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *SetContactVerifiedRes) Reset() {
+	*x = SetContactVerifiedRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContactVerifiedRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactVerifiedRes) ProtoMessage() {}
+
+func (x *SetContactVerifiedRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactVerifiedRes.ProtoReflect.Descriptor instead.
+func (*SetContactVerifiedRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetContactVerifiedRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ContactEntry is what the local contact directory knows about a contact.
+type ContactEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// nickname is unique among the entries.
+	Nickname string   `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Tags     []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Notes    string   `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *ContactEntry) Reset() {
+	*x = ContactEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactEntry) ProtoMessage() {}
+
+func (x *ContactEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactEntry.ProtoReflect.Descriptor instead.
+func (*ContactEntry) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ContactEntry) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *ContactEntry) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *ContactEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ContactEntry) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type SetContactEntryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// entry replaces the entry with the same public key, if any.
+	Entry *ContactEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *SetContactEntryReq) Reset() {
+	*x = SetContactEntryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContactEntryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactEntryReq) ProtoMessage() {}
+
+func (x *SetContactEntryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactEntryReq.ProtoReflect.Descriptor instead.
+func (*SetContactEntryReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetContactEntryReq) GetEntry() *ContactEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type SetContactEntryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *SetContactEntryRes) Reset() {
+	*x = SetContactEntryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetContactEntryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactEntryRes) ProtoMessage() {}
+
+func (x *SetContactEntryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactEntryRes.ProtoReflect.Descriptor instead.
+func (*SetContactEntryRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetContactEntryRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetContactEntryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (x *GetContactEntryReq) Reset() {
+	*x = GetContactEntryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactEntryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactEntryReq) ProtoMessage() {}
+
+func (x *GetContactEntryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactEntryReq.ProtoReflect.Descriptor instead.
+func (*GetContactEntryReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetContactEntryReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+type GetContactEntryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *ContactEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *GetContactEntryRes) Reset() {
+	*x = GetContactEntryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactEntryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactEntryRes) ProtoMessage() {}
+
+func (x *GetContactEntryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactEntryRes.ProtoReflect.Descriptor instead.
+func (*GetContactEntryRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetContactEntryRes) GetEntry() *ContactEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ListContactEntriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tag lists the entries having this tag only.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *ListContactEntriesReq) Reset() {
+	*x = ListContactEntriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContactEntriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactEntriesReq) ProtoMessage() {}
+
+func (x *ListContactEntriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactEntriesReq.ProtoReflect.Descriptor instead.
+func (*ListContactEntriesReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListContactEntriesReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type ListContactEntriesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*ContactEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListContactEntriesRes) Reset() {
+	*x = ListContactEntriesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContactEntriesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactEntriesRes) ProtoMessage() {}
+
+func (x *ListContactEntriesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactEntriesRes.ProtoReflect.Descriptor instead.
+func (*ListContactEntriesRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListContactEntriesRes) GetEntries() []*ContactEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type DeleteContactEntryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (x *DeleteContactEntryReq) Reset() {
+	*x = DeleteContactEntryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContactEntryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactEntryReq) ProtoMessage() {}
+
+func (x *DeleteContactEntryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactEntryReq.ProtoReflect.Descriptor instead.
+func (*DeleteContactEntryReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteContactEntryReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+type DeleteContactEntryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteContactEntryRes) Reset() {
+	*x = DeleteContactEntryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContactEntryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactEntryRes) ProtoMessage() {}
+
+func (x *DeleteContactEntryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactEntryRes.ProtoReflect.Descriptor instead.
+func (*DeleteContactEntryRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteContactEntryRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListContactsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resolve_alias_keys replays the contact groups to report alias keys.
+	ResolveAliasKeys bool `protobuf:"varint,1,opt,name=resolve_alias_keys,json=resolveAliasKeys,proto3" json:"resolve_alias_keys,omitempty"`
+}
+
+func (x *ListContactsReq) Reset() {
+	*x = ListContactsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContactsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsReq) ProtoMessage() {}
+
+func (x *ListContactsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsReq.ProtoReflect.Descriptor instead.
+func (*ListContactsReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListContactsReq) GetResolveAliasKeys() bool {
+	if x != nil {
+		return x.ResolveAliasKeys
+	}
+	return false
+}
+
+type ListContactsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contacts []*Contact `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+}
+
+func (x *ListContactsRes) Reset() {
+	*x = ListContactsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContactsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsRes) ProtoMessage() {}
+
+func (x *ListContactsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsRes.ProtoReflect.Descriptor instead.
+func (*ListContactsRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListContactsRes) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type Contact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string           `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Name      string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	State     ContactState     `protobuf:"varint,3,opt,name=state,proto3,enum=ContactState" json:"state,omitempty"`
+	Direction ContactDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=ContactDirection" json:"direction,omitempty"`
+	// created_at and updated_at are the unix times, in seconds, at which the
+	// first and last events about the contact were first seen by this module.
+	CreatedAt     int64        `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64        `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Blocked       bool         `protobuf:"varint,7,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	AliasKeyAdded bool         `protobuf:"varint,8,opt,name=alias_key_added,json=aliasKeyAdded,proto3" json:"alias_key_added,omitempty"`
+	Card          *ContactCard `protobuf:"bytes,9,opt,name=card,proto3" json:"card,omitempty"`
+	// verified is set with SetContactVerified once the safety number was
+	// compared out of band.
+	Verified bool `protobuf:"varint,10,opt,name=verified,proto3" json:"verified,omitempty"`
+	// nickname, tags and notes come from the contact directory.
+	Nickname string   `protobuf:"bytes,11,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Tags     []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	Notes    string   `protobuf:"bytes,13,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Contact) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetState() ContactState {
+	if x != nil {
+		return x.State
+	}
+	return ContactState_CONTACT_STATE_UNDEFINED
+}
+
+func (x *Contact) GetDirection() ContactDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return ContactDirection_CONTACT_DIRECTION_UNDEFINED
+}
+
+func (x *Contact) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Contact) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Contact) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *Contact) GetAliasKeyAdded() bool {
+	if x != nil {
+		return x.AliasKeyAdded
+	}
+	return false
+}
+
+func (x *Contact) GetCard() *ContactCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *Contact) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Contact) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *Contact) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Contact) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type WatchContactRequestsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// replay_history sends the past events, oldest first, before the new ones.
+	ReplayHistory bool `protobuf:"varint,1,opt,name=replay_history,json=replayHistory,proto3" json:"replay_history,omitempty"`
+}
+
+func (x *WatchContactRequestsReq) Reset() {
+	*x = WatchContactRequestsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchContactRequestsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchContactRequestsReq) ProtoMessage() {}
+
+func (x *WatchContactRequestsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchContactRequestsReq.ProtoReflect.Descriptor instead.
+func (*WatchContactRequestsReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *WatchContactRequestsReq) GetReplayHistory() bool {
+	if x != nil {
+		return x.ReplayHistory
+	}
+	return false
+}
+
+type ContactRequestEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type ContactRequestEventType `protobuf:"varint,2,opt,name=type,proto3,enum=ContactRequestEventType" json:"type,omitempty"`
+	// contact is the state of the contact after the event.
+	Contact *Contact `protobuf:"bytes,3,opt,name=contact,proto3" json:"contact,omitempty"`
+}
+
+func (x *ContactRequestEvent) Reset() {
+	*x = ContactRequestEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactRequestEvent) ProtoMessage() {}
+
+func (x *ContactRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactRequestEvent.ProtoReflect.Descriptor instead.
+func (*ContactRequestEvent) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ContactRequestEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContactRequestEvent) GetType() ContactRequestEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_UNDEFINED
+}
+
+func (x *ContactRequestEvent) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type ListContactDecisionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListContactDecisionsReq) Reset() {
+	*x = ListContactDecisionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContactDecisionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactDecisionsReq) ProtoMessage() {}
+
+func (x *ListContactDecisionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactDecisionsReq.ProtoReflect.Descriptor instead.
+func (*ListContactDecisionsReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{42}
+}
+
+type ListContactDecisionsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// decisions are sorted oldest first.
+	Decisions []*ContactDecision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+}
+
+func (x *ListContactDecisionsRes) Reset() {
+	*x = ListContactDecisionsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContactDecisionsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactDecisionsRes) ProtoMessage() {}
+
+func (x *ListContactDecisionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactDecisionsRes.ProtoReflect.Descriptor instead.
+func (*ListContactDecisionsRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListContactDecisionsRes) GetDecisions() []*ContactDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+// ContactDecision records what the contact policy did with a request.
+type ContactDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the ID of the contact request event.
+	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublicKey string        `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Name      string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Action    ContactAction `protobuf:"varint,4,opt,name=action,proto3,enum=ContactAction" json:"action,omitempty"`
+	Reason    string        `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// decided_at is a unix time in seconds.
+	DecidedAt int64 `protobuf:"varint,6,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+}
+
+func (x *ContactDecision) Reset() {
+	*x = ContactDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactDecision) ProtoMessage() {}
+
+func (x *ContactDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactDecision.ProtoReflect.Descriptor instead.
+func (*ContactDecision) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ContactDecision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContactDecision) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *ContactDecision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContactDecision) GetAction() ContactAction {
+	if x != nil {
+		return x.Action
+	}
+	return ContactAction_CONTACT_ACTION_HOLD
+}
+
+func (x *ContactDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ContactDecision) GetDecidedAt() int64 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+// ContactCard is what a contact tells about itself in its contact request.
+type ContactCard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version is 0 for contacts sending their name as a plain string.
+	Version     uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Avatar      []byte `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// avatar_ref points to an avatar stored elsewhere, such as an URL or a CID.
+	AvatarRef  string            `protobuf:"bytes,4,opt,name=avatar_ref,json=avatarRef,proto3" json:"avatar_ref,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// intro is a note explaining why the contact is asking.
+	Intro string `protobuf:"bytes,6,opt,name=intro,proto3" json:"intro,omitempty"`
+}
+
+func (x *ContactCard) Reset() {
+	*x = ContactCard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactCard) ProtoMessage() {}
+
+func (x *ContactCard) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactCard.ProtoReflect.Descriptor instead.
+func (*ContactCard) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ContactCard) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ContactCard) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ContactCard) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *ContactCard) GetAvatarRef() string {
+	if x != nil {
+		return x.AvatarRef
+	}
+	return ""
+}
+
+func (x *ContactCard) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ContactCard) GetIntro() string {
+	if x != nil {
+		return x.Intro
+	}
+	return ""
+}
+
+type SendMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey    string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	IsContact bool   `protobuf:"varint,3,opt,name=isContact,proto3" json:"isContact,omitempty"`
+	// payload is sent instead of message when set.
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// content_type describes the payload, it is delivered along with it.
+	ContentType string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// format selects how the message is encoded. The messenger format supports
+	// text messages only and ignores payload and content_type.
+	Format         MessageFormat  `protobuf:"varint,6,opt,name=format,proto3,enum=MessageFormat" json:"format,omitempty"`
+	AppMessageType AppMessageType `protobuf:"varint,7,opt,name=app_message_type,json=appMessageType,proto3,enum=AppMessageType" json:"app_message_type,omitempty"`
+	// target_id is the id of the message replied to or acknowledged.
+	TargetId string `protobuf:"bytes,8,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// nickname sends to the contact with this nickname in the contact
+	// directory, instead of pubkey.
+	Nickname string `protobuf:"bytes,9,opt,name=nickname,proto3" json:"nickname,omitempty"`
+}
+
+func (x *SendMessageReq) Reset() {
+	*x = SendMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageReq) ProtoMessage() {}
+
+func (x *SendMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageReq.ProtoReflect.Descriptor instead.
+func (*SendMessageReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SendMessageReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *SendMessageReq) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendMessageReq) GetIsContact() bool {
+	if x != nil {
+		return x.IsContact
+	}
+	return false
+}
+
+func (x *SendMessageReq) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SendMessageReq) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *SendMessageReq) GetFormat() MessageFormat {
+	if x != nil {
+		return x.Format
+	}
+	return MessageFormat_MESSAGE_FORMAT_RAW
+}
+
+func (x *SendMessageReq) GetAppMessageType() AppMessageType {
+	if x != nil {
+		return x.AppMessageType
+	}
+	return AppMessageType_APP_MESSAGE_TYPE_UNDEFINED
+}
+
+func (x *SendMessageReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SendMessageReq) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type SendMessageRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SendMessageRes) Reset() {
+	*x = SendMessageRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMessageRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRes) ProtoMessage() {}
+
+func (x *SendMessageRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRes.ProtoReflect.Descriptor instead.
+func (*SendMessageRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SendMessageRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendMessageRes) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMessagesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey    string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	IsContact bool   `protobuf:"varint,2,opt,name=isContact,proto3" json:"isContact,omitempty"`
+	// format selects how messages are decoded. Messages that cannot be decoded
+	// with the messenger format are returned raw.
+	Format MessageFormat `protobuf:"varint,3,opt,name=format,proto3,enum=MessageFormat" json:"format,omitempty"`
+	// since_id and until_id bound the listed messages, which exclude them.
+	SinceId string `protobuf:"bytes,4,opt,name=since_id,json=sinceId,proto3" json:"since_id,omitempty"`
+	UntilId string `protobuf:"bytes,5,opt,name=until_id,json=untilId,proto3" json:"until_id,omitempty"`
+	// limit caps the number of returned messages, 0 meaning no limit.
+	Limit uint32    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Order ListOrder `protobuf:"varint,7,opt,name=order,proto3,enum=ListOrder" json:"order,omitempty"`
+	// exclude_own skips the messages sent by this device.
+	ExcludeOwn bool `protobuf:"varint,8,opt,name=exclude_own,json=excludeOwn,proto3" json:"exclude_own,omitempty"`
+}
+
+func (x *ListMessagesReq) Reset() {
+	*x = ListMessagesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMessagesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesReq) ProtoMessage() {}
+
+func (x *ListMessagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesReq.ProtoReflect.Descriptor instead.
+func (*ListMessagesReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListMessagesReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *ListMessagesReq) GetIsContact() bool {
+	if x != nil {
+		return x.IsContact
+	}
+	return false
+}
+
+func (x *ListMessagesReq) GetFormat() MessageFormat {
+	if x != nil {
+		return x.Format
+	}
+	return MessageFormat_MESSAGE_FORMAT_RAW
+}
+
+func (x *ListMessagesReq) GetSinceId() string {
+	if x != nil {
+		return x.SinceId
+	}
+	return ""
+}
+
+func (x *ListMessagesReq) GetUntilId() string {
+	if x != nil {
+		return x.UntilId
+	}
+	return ""
+}
+
+func (x *ListMessagesReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMessagesReq) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_NEWEST_FIRST
+}
+
+func (x *ListMessagesReq) GetExcludeOwn() bool {
+	if x != nil {
+		return x.ExcludeOwn
+	}
+	return false
+}
+
+type ListMessagesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message   string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	DevicePk  string   `protobuf:"bytes,4,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
+	MemberPk  string   `protobuf:"bytes,5,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
+	// payload holds the message bytes as sent, message being a UTF-8 rendering
+	// of it where invalid sequences are replaced.
+	Payload        []byte         `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	ContentType    string         `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ValidUtf8      bool           `protobuf:"varint,8,opt,name=valid_utf8,json=validUtf8,proto3" json:"valid_utf8,omitempty"`
+	AppMessageType AppMessageType `protobuf:"varint,9,opt,name=app_message_type,json=appMessageType,proto3,enum=AppMessageType" json:"app_message_type,omitempty"`
+	TargetId       string         `protobuf:"bytes,10,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// sent_date is the sender's clock in milliseconds, messenger format only.
+	SentDate int64 `protobuf:"varint,11,opt,name=sent_date,json=sentDate,proto3" json:"sent_date,omitempty"`
+}
+
+func (x *ListMessagesRes) Reset() {
+	*x = ListMessagesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMessagesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesRes) ProtoMessage() {}
+
+func (x *ListMessagesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesRes.ProtoReflect.Descriptor instead.
+func (*ListMessagesRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListMessagesRes) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListMessagesRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListMessagesRes) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *ListMessagesRes) GetDevicePk() string {
+	if x != nil {
+		return x.DevicePk
+	}
+	return ""
+}
+
+func (x *ListMessagesRes) GetMemberPk() string {
+	if x != nil {
+		return x.MemberPk
+	}
+	return ""
+}
+
+func (x *ListMessagesRes) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ListMessagesRes) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ListMessagesRes) GetValidUtf8() bool {
+	if x != nil {
+		return x.ValidUtf8
+	}
+	return false
+}
+
+func (x *ListMessagesRes) GetAppMessageType() AppMessageType {
+	if x != nil {
+		return x.AppMessageType
+	}
+	return AppMessageType_APP_MESSAGE_TYPE_UNDEFINED
+}
+
+func (x *ListMessagesRes) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ListMessagesRes) GetSentDate() int64 {
+	if x != nil {
+		return x.SentDate
+	}
+	return 0
+}
+
+type SubscribeMessagesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey    string        `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	IsContact bool          `protobuf:"varint,2,opt,name=isContact,proto3" json:"isContact,omitempty"`
+	Format    MessageFormat `protobuf:"varint,3,opt,name=format,proto3,enum=MessageFormat" json:"format,omitempty"`
+	// replay_history sends the existing messages, oldest first, before the new ones.
+	ReplayHistory bool `protobuf:"varint,4,opt,name=replay_history,json=replayHistory,proto3" json:"replay_history,omitempty"`
+	// since_id replays the messages following this one, it implies replay_history.
+	SinceId string `protobuf:"bytes,5,opt,name=since_id,json=sinceId,proto3" json:"since_id,omitempty"`
+}
+
+func (x *SubscribeMessagesReq) Reset() {
+	*x = SubscribeMessagesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeMessagesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeMessagesReq) ProtoMessage() {}
+
+func (x *SubscribeMessagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeMessagesReq.ProtoReflect.Descriptor instead.
+func (*SubscribeMessagesReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SubscribeMessagesReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *SubscribeMessagesReq) GetIsContact() bool {
+	if x != nil {
+		return x.IsContact
+	}
+	return false
+}
+
+func (x *SubscribeMessagesReq) GetFormat() MessageFormat {
+	if x != nil {
+		return x.Format
+	}
+	return MessageFormat_MESSAGE_FORMAT_RAW
+}
+
+func (x *SubscribeMessagesReq) GetReplayHistory() bool {
+	if x != nil {
+		return x.ReplayHistory
+	}
+	return false
+}
+
+func (x *SubscribeMessagesReq) GetSinceId() string {
+	if x != nil {
+		return x.SinceId
+	}
+	return ""
+}
+
+type CreateGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name, description and avatar are published as the group profile.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Avatar      []byte `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+}
+
+func (x *CreateGroupReq) Reset() {
+	*x = CreateGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupReq) ProtoMessage() {}
+
+func (x *CreateGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupReq.ProtoReflect.Descriptor instead.
+func (*CreateGroupReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateGroupReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateGroupReq) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+type CreateGroupRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupPk         string `protobuf:"bytes,1,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
+	GroupInvitation string `protobuf:"bytes,2,opt,name=groupInvitation,proto3" json:"groupInvitation,omitempty"`
+}
+
+func (x *CreateGroupRes) Reset() {
+	*x = CreateGroupRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRes) ProtoMessage() {}
+
+func (x *CreateGroupRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRes.ProtoReflect.Descriptor instead.
+func (*CreateGroupRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateGroupRes) GetGroupPk() string {
+	if x != nil {
+		return x.GroupPk
+	}
+	return ""
+}
+
+func (x *CreateGroupRes) GetGroupInvitation() string {
+	if x != nil {
+		return x.GroupInvitation
+	}
+	return ""
+}
+
+type JoinGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupInvitation string `protobuf:"bytes,1,opt,name=groupInvitation,proto3" json:"groupInvitation,omitempty"`
+}
+
+func (x *JoinGroupReq) Reset() {
+	*x = JoinGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupReq) ProtoMessage() {}
+
+func (x *JoinGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupReq.ProtoReflect.Descriptor instead.
+func (*JoinGroupReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *JoinGroupReq) GetGroupInvitation() string {
+	if x != nil {
+		return x.GroupInvitation
+	}
+	return ""
+}
+
+type JoinGroupRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GroupPk string `protobuf:"bytes,2,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
+}
+
+func (x *JoinGroupRes) Reset() {
+	*x = JoinGroupRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinGroupRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupRes) ProtoMessage() {}
+
+func (x *JoinGroupRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupRes.ProtoReflect.Descriptor instead.
+func (*JoinGroupRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *JoinGroupRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *JoinGroupRes) GetGroupPk() string {
+	if x != nil {
+		return x.GroupPk
+	}
+	return ""
+}
+
+type ListGroupsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// include_left also lists the groups we left.
+	IncludeLeft bool `protobuf:"varint,1,opt,name=include_left,json=includeLeft,proto3" json:"include_left,omitempty"`
+}
+
+func (x *ListGroupsReq) Reset() {
+	*x = ListGroupsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsReq) ProtoMessage() {}
+
+func (x *ListGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsReq.ProtoReflect.Descriptor instead.
+func (*ListGroupsReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListGroupsReq) GetIncludeLeft() bool {
+	if x != nil {
+		return x.IncludeLeft
+	}
+	return false
+}
+
+type ListGroupsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// groups are sorted in the order they were joined.
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *ListGroupsRes) Reset() {
+	*x = ListGroupsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRes) ProtoMessage() {}
+
+func (x *ListGroupsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRes.ProtoReflect.Descriptor instead.
+func (*ListGroupsRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListGroupsRes) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string    `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Type      GroupType `protobuf:"varint,2,opt,name=type,proto3,enum=GroupType" json:"type,omitempty"`
+	// active is set for the groups activated through this module and not
+	// deactivated since.
+	Active bool `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	// member_pk is our member key in the group, unset for the groups we left.
+	MemberPk string `protobuf:"bytes,4,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
+	// joined_at is the unix time, in seconds, at which the join was first seen.
+	JoinedAt int64 `protobuf:"varint,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	Left     bool  `protobuf:"varint,6,opt,name=left,proto3" json:"left,omitempty"`
+	// profile is the last published group profile, unset for the groups we left.
+	Profile *GroupProfile `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Group) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *Group) GetType() GroupType {
+	if x != nil {
+		return x.Type
+	}
+	return GroupType_GROUP_TYPE_UNDEFINED
+}
+
+func (x *Group) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Group) GetMemberPk() string {
+	if x != nil {
+		return x.MemberPk
+	}
+	return ""
+}
+
+func (x *Group) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+func (x *Group) GetLeft() bool {
+	if x != nil {
+		return x.Left
+	}
+	return false
+}
+
+func (x *Group) GetProfile() *GroupProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type LeaveGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupPk string `protobuf:"bytes,1,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
+}
+
+func (x *LeaveGroupReq) Reset() {
+	*x = LeaveGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupReq) ProtoMessage() {}
+
+func (x *LeaveGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupReq.ProtoReflect.Descriptor instead.
+func (*LeaveGroupReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LeaveGroupReq) GetGroupPk() string {
+	if x != nil {
+		return x.GroupPk
+	}
+	return ""
+}
+
+type LeaveGroupRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// was_active is set if the group was removed from the activation list.
+	WasActive bool `protobuf:"varint,2,opt,name=was_active,json=wasActive,proto3" json:"was_active,omitempty"`
+	// cached_devices is the number of member devices dropped from the cache.
+	CachedDevices int32 `protobuf:"varint,3,opt,name=cached_devices,json=cachedDevices,proto3" json:"cached_devices,omitempty"`
+	// closed_subscriptions is the number of SubscribeMessages and
+	// WatchGroupMembers streams ended.
+	ClosedSubscriptions int32 `protobuf:"varint,4,opt,name=closed_subscriptions,json=closedSubscriptions,proto3" json:"closed_subscriptions,omitempty"`
+}
+
+func (x *LeaveGroupRes) Reset() {
+	*x = LeaveGroupRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveGroupRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupRes) ProtoMessage() {}
+
+func (x *LeaveGroupRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupRes.ProtoReflect.Descriptor instead.
+func (*LeaveGroupRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LeaveGroupRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LeaveGroupRes) GetWasActive() bool {
+	if x != nil {
+		return x.WasActive
+	}
+	return false
+}
+
+func (x *LeaveGroupRes) GetCachedDevices() int32 {
+	if x != nil {
+		return x.CachedDevices
+	}
+	return 0
+}
+
+func (x *LeaveGroupRes) GetClosedSubscriptions() int32 {
+	if x != nil {
+		return x.ClosedSubscriptions
+	}
+	return 0
+}
+
+type ListGroupMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey    string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	IsContact bool   `protobuf:"varint,2,opt,name=isContact,proto3" json:"isContact,omitempty"`
+}
+
+func (x *ListGroupMembersReq) Reset() {
+	*x = ListGroupMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersReq) ProtoMessage() {}
+
+func (x *ListGroupMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersReq.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListGroupMembersReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *ListGroupMembersReq) GetIsContact() bool {
+	if x != nil {
+		return x.IsContact
+	}
+	return false
+}
+
+type ListGroupMembersRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// members are sorted in the order they were first seen.
+	Members []*GroupMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *ListGroupMembersRes) Reset() {
+	*x = ListGroupMembersRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupMembersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersRes) ProtoMessage() {}
+
+func (x *ListGroupMembersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersRes.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListGroupMembersRes) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GroupMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberPk  string   `protobuf:"bytes,1,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
+	DevicePks []string `protobuf:"bytes,2,rep,name=device_pks,json=devicePks,proto3" json:"device_pks,omitempty"`
+	// admin is set for the group creator and the members granted the role.
+	Admin bool `protobuf:"varint,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	// first_seen_at is the unix time, in seconds, at which the member was
+	// first seen by this module.
+	FirstSeenAt int64 `protobuf:"varint,4,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	// self is set for our own member.
+	Self bool `protobuf:"varint,5,opt,name=self,proto3" json:"self,omitempty"`
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMember) ProtoMessage() {}
+
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GroupMember) GetMemberPk() string {
+	if x != nil {
+		return x.MemberPk
+	}
+	return ""
+}
+
+func (x *GroupMember) GetDevicePks() []string {
+	if x != nil {
+		return x.DevicePks
+	}
+	return nil
+}
+
+func (x *GroupMember) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+func (x *GroupMember) GetFirstSeenAt() int64 {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return 0
+}
+
+func (x *GroupMember) GetSelf() bool {
+	if x != nil {
+		return x.Self
+	}
+	return false
+}
+
+type WatchGroupMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey    string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	IsContact bool   `protobuf:"varint,2,opt,name=isContact,proto3" json:"isContact,omitempty"`
+	// replay_history sends the past events, oldest first, before the new ones.
+	ReplayHistory bool `protobuf:"varint,3,opt,name=replay_history,json=replayHistory,proto3" json:"replay_history,omitempty"`
+}
+
+func (x *WatchGroupMembersReq) Reset() {
+	*x = WatchGroupMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchGroupMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGroupMembersReq) ProtoMessage() {}
+
+func (x *WatchGroupMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGroupMembersReq.ProtoReflect.Descriptor instead.
+func (*WatchGroupMembersReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *WatchGroupMembersReq) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *WatchGroupMembersReq) GetIsContact() bool {
+	if x != nil {
+		return x.IsContact
+	}
+	return false
+}
+
+func (x *WatchGroupMembersReq) GetReplayHistory() bool {
+	if x != nil {
+		return x.ReplayHistory
+	}
+	return false
+}
+
+type GroupMemberEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type GroupMemberEventType `protobuf:"varint,2,opt,name=type,proto3,enum=GroupMemberEventType" json:"type,omitempty"`
+	// member is the state of the member after the event.
+	Member *GroupMember `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	// device_pk is the device added, for MEMBER_JOINED and DEVICE_ADDED.
+	DevicePk string `protobuf:"bytes,4,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
+}
+
+func (x *GroupMemberEvent) Reset() {
+	*x = GroupMemberEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMemberEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberEvent) ProtoMessage() {}
+
+func (x *GroupMemberEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberEvent.ProtoReflect.Descriptor instead.
+func (*GroupMemberEvent) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GroupMemberEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupMemberEvent) GetType() GroupMemberEventType {
+	if x != nil {
+		return x.Type
+	}
+	return GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_UNDEFINED
+}
+
+func (x *GroupMemberEvent) GetMember() *GroupMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *GroupMemberEvent) GetDevicePk() string {
+	if x != nil {
+		return x.DevicePk
+	}
+	return ""
+}
+
+type GroupProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Avatar      []byte `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+}
+
+func (x *GroupProfile) Reset() {
+	*x = GroupProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupProfile) ProtoMessage() {}
+
+func (x *GroupProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupProfile.ProtoReflect.Descriptor instead.
+func (*GroupProfile) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GroupProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GroupProfile) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+type UpdateGroupProfileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupPk string `protobuf:"bytes,1,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
+	// the fields left empty keep their current value.
+	Profile *GroupProfile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *UpdateGroupProfileReq) Reset() {
+	*x = UpdateGroupProfileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupProfileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupProfileReq) ProtoMessage() {}
+
+func (x *UpdateGroupProfileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupProfileReq.ProtoReflect.Descriptor instead.
+func (*UpdateGroupProfileReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateGroupProfileReq) GetGroupPk() string {
+	if x != nil {
+		return x.GroupPk
+	}
+	return ""
+}
+
+func (x *UpdateGroupProfileReq) GetProfile() *GroupProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateGroupProfileRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// profile is the published profile, merged with the current one.
+	Profile *GroupProfile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *UpdateGroupProfileRes) Reset() {
+	*x = UpdateGroupProfileRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupProfileRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupProfileRes) ProtoMessage() {}
+
+func (x *UpdateGroupProfileRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupProfileRes.ProtoReflect.Descriptor instead.
+func (*UpdateGroupProfileRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateGroupProfileRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateGroupProfileRes) GetProfile() *GroupProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetGroupProfileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupPk string `protobuf:"bytes,1,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
+}
+
+func (x *GetGroupProfileReq) Reset() {
+	*x = GetGroupProfileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupProfileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupProfileReq) ProtoMessage() {}
+
+func (x *GetGroupProfileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupProfileReq.ProtoReflect.Descriptor instead.
+func (*GetGroupProfileReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetGroupProfileReq) GetGroupPk() string {
+	if x != nil {
+		return x.GroupPk
+	}
+	return ""
+}
+
+type GetGroupProfileRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// profile is empty if none was published.
+	Profile *GroupProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// updated_by is the member PK of the last member to publish the profile.
+	UpdatedBy string         `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Settings  *GroupSettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GetGroupProfileRes) Reset() {
+	*x = GetGroupProfileRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupProfileRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupProfileRes) ProtoMessage() {}
+
+func (x *GetGroupProfileRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupProfileRes.ProtoReflect.Descriptor instead.
+func (*GetGroupProfileRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetGroupProfileRes) GetProfile() *GroupProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetGroupProfileRes) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *GetGroupProfileRes) GetSettings() *GroupSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GroupSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// announcement_only restricts posting to the admins, when the module
+	// enforces an admin policy.
+	AnnouncementOnly bool `protobuf:"varint,1,opt,name=announcement_only,json=announcementOnly,proto3" json:"announcement_only,omitempty"`
+}
+
+func (x *GroupSettings) Reset() {
+	*x = GroupSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupSettings) ProtoMessage() {}
+
+func (x *GroupSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupSettings.ProtoReflect.Descriptor instead.
+func (*GroupSettings) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GroupSettings) GetAnnouncementOnly() bool {
+	if x != nil {
+		return x.AnnouncementOnly
+	}
+	return false
+}
+
+type SetGroupSettingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupPk string `protobuf:"bytes,1,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
+	// settings replace the current ones.
+	Settings *GroupSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *SetGroupSettingsReq) Reset() {
+	*x = SetGroupSettingsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupSettingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupSettingsReq) ProtoMessage() {}
+
+func (x *SetGroupSettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupSettingsReq.ProtoReflect.Descriptor instead.
+func (*SetGroupSettingsReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetGroupSettingsReq) GetGroupPk() string {
+	if x != nil {
+		return x.GroupPk
+	}
+	return ""
+}
+
+func (x *SetGroupSettingsReq) GetSettings() *GroupSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetGroupSettingsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *SetGroupSettingsRes) Reset() {
+	*x = SetGroupSettingsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGroupSettingsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupSettingsRes) ProtoMessage() {}
+
+func (x *SetGroupSettingsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupSettingsRes.ProtoReflect.Descriptor instead.
+func (*SetGroupSettingsRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetGroupSettingsRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateGroupInvitationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupPk string `protobuf:"bytes,1,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
+}
+
+func (x *CreateGroupInvitationReq) Reset() {
+	*x = CreateGroupInvitationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupInvitationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInvitationReq) ProtoMessage() {}
+
+func (x *CreateGroupInvitationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInvitationReq.ProtoReflect.Descriptor instead.
+func (*CreateGroupInvitationReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateGroupInvitationReq) GetGroupPk() string {
+	if x != nil {
+		return x.GroupPk
+	}
+	return ""
+}
+
+type CreateGroupInvitationRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupInvitation string `protobuf:"bytes,1,opt,name=groupInvitation,proto3" json:"groupInvitation,omitempty"`
+}
+
+func (x *CreateGroupInvitationRes) Reset() {
+	*x = CreateGroupInvitationRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupInvitationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupInvitationRes) ProtoMessage() {}
+
+func (x *CreateGroupInvitationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupInvitationRes.ProtoReflect.Descriptor instead.
+func (*CreateGroupInvitationRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateGroupInvitationRes) GetGroupInvitation() string {
+	if x != nil {
+		return x.GroupInvitation
+	}
+	return ""
+}
+
+type GrantGroupAdminReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupPk  string `protobuf:"bytes,1,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
+	MemberPk string `protobuf:"bytes,2,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
+}
+
+func (x *GrantGroupAdminReq) Reset() {
+	*x = GrantGroupAdminReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantGroupAdminReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantGroupAdminReq) ProtoMessage() {}
+
+func (x *GrantGroupAdminReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantGroupAdminReq.ProtoReflect.Descriptor instead.
+func (*GrantGroupAdminReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GrantGroupAdminReq) GetGroupPk() string {
+	if x != nil {
+		return x.GroupPk
+	}
+	return ""
+}
+
+func (x *GrantGroupAdminReq) GetMemberPk() string {
+	if x != nil {
+		return x.MemberPk
+	}
+	return ""
+}
+
+type GrantGroupAdminRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *GrantGroupAdminRes) Reset() {
+	*x = GrantGroupAdminRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantGroupAdminRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantGroupAdminRes) ProtoMessage() {}
+
+func (x *GrantGroupAdminRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantGroupAdminRes.ProtoReflect.Descriptor instead.
+func (*GrantGroupAdminRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GrantGroupAdminRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListGroupAdminsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupPk string `protobuf:"bytes,1,opt,name=groupPk,proto3" json:"groupPk,omitempty"`
+}
+
+func (x *ListGroupAdminsReq) Reset() {
+	*x = ListGroupAdminsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupAdminsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupAdminsReq) ProtoMessage() {}
+
+func (x *ListGroupAdminsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupAdminsReq.ProtoReflect.Descriptor instead.
+func (*ListGroupAdminsReq) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListGroupAdminsReq) GetGroupPk() string {
+	if x != nil {
+		return x.GroupPk
+	}
+	return ""
+}
+
+type ListGroupAdminsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Admins []*GroupMember `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+}
+
+func (x *ListGroupAdminsRes) Reset() {
+	*x = ListGroupAdminsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupAdminsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupAdminsRes) ProtoMessage() {}
+
+func (x *ListGroupAdminsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupAdminsRes.ProtoReflect.Descriptor instead.
+func (*ListGroupAdminsRes) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListGroupAdminsRes) GetAdmins() []*GroupMember {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+// AppMetadata frames the typed events the module sends with AppMetadataSend.
+type AppMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*AppMetadata_GroupProfile
+	//	*AppMetadata_GroupSettings
+	Event isAppMetadata_Event `protobuf_oneof:"event"`
+}
+
+func (x *AppMetadata) Reset() {
+	*x = AppMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppMetadata) ProtoMessage() {}
+
+func (x *AppMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppMetadata.ProtoReflect.Descriptor instead.
+func (*AppMetadata) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{79}
+}
+
+func (m *AppMetadata) GetEvent() isAppMetadata_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *AppMetadata) GetGroupProfile() *GroupProfile {
+	if x, ok := x.GetEvent().(*AppMetadata_GroupProfile); ok {
+		return x.GroupProfile
+	}
+	return nil
+}
+
+func (x *AppMetadata) GetGroupSettings() *GroupSettings {
+	if x, ok := x.GetEvent().(*AppMetadata_GroupSettings); ok {
+		return x.GroupSettings
+	}
+	return nil
+}
+
+type isAppMetadata_Event interface {
+	isAppMetadata_Event()
+}
+
+type AppMetadata_GroupProfile struct {
+	GroupProfile *GroupProfile `protobuf:"bytes,1,opt,name=group_profile,json=groupProfile,proto3,oneof"`
+}
+
+type AppMetadata_GroupSettings struct {
+	GroupSettings *GroupSettings `protobuf:"bytes,2,opt,name=group_settings,json=groupSettings,proto3,oneof"`
+}
+
+func (*AppMetadata_GroupProfile) isAppMetadata_Event() {}
+
+func (*AppMetadata_GroupSettings) isAppMetadata_Event() {}
+
+// Envelope frames a payload sent along with its content type.
+type Envelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Payload     []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Envelope) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Envelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type GetContactRequestsRes_ContactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PublicKey string       `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Card      *ContactCard `protobuf:"bytes,3,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *GetContactRequestsRes_ContactRequest) Reset() {
+	*x = GetContactRequestsRes_ContactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactRequestsRes_ContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactRequestsRes_ContactRequest) ProtoMessage() {}
+
+func (x *GetContactRequestsRes_ContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactRequestsRes_ContactRequest.ProtoReflect.Descriptor instead.
+func (*GetContactRequestsRes_ContactRequest) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *GetContactRequestsRes_ContactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetContactRequestsRes_ContactRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *GetContactRequestsRes_ContactRequest) GetCard() *ContactCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type ListOutgoingContactRequestsRes_OutgoingContactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string                `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Name      string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status    OutgoingRequestStatus `protobuf:"varint,3,opt,name=status,proto3,enum=OutgoingRequestStatus" json:"status,omitempty"`
+	// created_at and updated_at are unix times in seconds, see Contact.
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ListOutgoingContactRequestsRes_OutgoingContactRequest) Reset() {
+	*x = ListOutgoingContactRequestsRes_OutgoingContactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messenger_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutgoingContactRequestsRes_OutgoingContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingContactRequestsRes_OutgoingContactRequest) ProtoMessage() {}
+
+func (x *ListOutgoingContactRequestsRes_OutgoingContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messenger_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingContactRequestsRes_OutgoingContactRequest.ProtoReflect.Descriptor instead.
+func (*ListOutgoingContactRequestsRes_OutgoingContactRequest) Descriptor() ([]byte, []int) {
+	return file_messenger_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *ListOutgoingContactRequestsRes_OutgoingContactRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *ListOutgoingContactRequestsRes_OutgoingContactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListOutgoingContactRequestsRes_OutgoingContactRequest) GetStatus() OutgoingRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_UNKNOWN
+}
+
+func (x *ListOutgoingContactRequestsRes_OutgoingContactRequest) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ListOutgoingContactRequestsRes_OutgoingContactRequest) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+var File_messenger_proto protoreflect.FileDescriptor
+
+var file_messenger_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x22, 0x61, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x64, 0x76, 0x53, 0x65,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x64, 0x76, 0x53, 0x65, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x0f, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x71, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x71, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x60, 0x0a, 0x0f, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0b,
+	0x71, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x71, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x6e, 0x67, 0x22, 0x1a, 0x0a, 0x18,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x22, 0x34, 0x0a, 0x18, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x64, 0x76, 0x53, 0x65, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x64, 0x76, 0x53, 0x65, 0x65, 0x64, 0x22, 0x1b,
+	0x0a, 0x19, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x22, 0x35, 0x0a, 0x19, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x22, 0x34,
+	0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x64,
+	0x76, 0x53, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x64, 0x76,
+	0x53, 0x65, 0x65, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x22, 0xd0, 0x01,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x65, 0x0a, 0x0e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x20,
+	0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x22, 0x93, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x64, 0x76, 0x53, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x64, 0x76, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x61, 0x72, 0x64,
+	0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x22, 0x61, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x4f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x20, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x22, 0xb0, 0x02, 0x0a, 0x1e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x52,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x1a, 0xb9, 0x01, 0x0a, 0x16, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x31,
+	0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x22, 0x33, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x32, 0x0a, 0x18, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x34, 0x0a, 0x18, 0x44, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x29, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x2b, 0x0a, 0x0f, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x55, 0x6e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x2d, 0x0a, 0x11, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x22, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x61, 0x66, 0x65,
+	0x74, 0x79, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x15, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x73, 0x0a, 0x0c, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x39,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x2e, 0x0a, 0x12, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x39, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x22, 0x29, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x40, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x2f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x4b, 0x65, 0x79, 0x73, 0x22, 0x37, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x96, 0x03,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x4b, 0x65, 0x79, 0x41, 0x64, 0x64,
+	0x65, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04,
+	0x63, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x77, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x22, 0x49, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0x94, 0x02,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x52, 0x65,
+	0x66, 0x12, 0x3c, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6e, 0x74, 0x72, 0x6f, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb9, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x39, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfe, 0x01, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x77, 0x6e, 0x22, 0xe5, 0x02,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x70, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x50, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x74, 0x66, 0x38,
+	0x12, 0x39, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x41, 0x70, 0x70,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x61, 0x70, 0x70,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x5e,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x22, 0x54,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42,
+	0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6b, 0x22, 0x32, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6c,
+	0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x2f, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x1e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x70, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x50, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22,
+	0x29, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x22, 0xa2, 0x01, 0x0a, 0x0d, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x73, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x61, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x14,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x4b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x3d, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0b,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x70, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x50, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x22, 0x0a,
+	0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x73, 0x65, 0x6c, 0x66, 0x22, 0x73, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6b, 0x22, 0x5c, 0x0a,
+	0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x22, 0x5a, 0x0a, 0x15, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x12, 0x27,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x5a, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x6b, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3c,
+	0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x5b, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x12, 0x2a, 0x0a,
+	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x34, 0x0a, 0x18, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b,
+	0x22, 0x44, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x12, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x50, 0x6b, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x6b, 0x22, 0x3a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22,
+	0x85, 0x01, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x34, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52,
+	0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x07,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2a, 0xaa, 0x01, 0x0a, 0x15, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x4f, 0x55,
+	0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x24, 0x0a, 0x20, 0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45,
+	0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e,
+	0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x55, 0x54, 0x47, 0x4f,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xbf, 0x01,
+	0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x4f, 0x4e,
+	0x54, 0x41, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0x5f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e,
+	0x54, 0x41, 0x43, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x45,
+	0x50, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44, 0x10, 0x02,
+	0x2a, 0xcf, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23,
+	0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x45,
+	0x4e, 0x54, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x52,
+	0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e,
+	0x54, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50,
+	0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44, 0x45, 0x44,
+	0x10, 0x05, 0x2a, 0x73, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43,
+	0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x45,
+	0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x43, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x43,
+	0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x43, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54,
+	0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0x45, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x01, 0x2a, 0x45,
+	0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x16, 0x0a, 0x12, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x52, 0x41, 0x57, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x45, 0x4e,
+	0x47, 0x45, 0x52, 0x10, 0x01, 0x2a, 0xc0, 0x01, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x50, 0x50, 0x5f,
+	0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x44,
+	0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x50, 0x50, 0x5f,
+	0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41,
+	0x50, 0x50, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x10, 0x02, 0x12, 0x25, 0x0a,
+	0x21, 0x41, 0x50, 0x50, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x50, 0x50, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x04, 0x2a, 0xbd, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x25, 0x0a, 0x21, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45,
+	0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x44,
+	0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x4d,
+	0x42, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
+	0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x29, 0x0a,
+	0x25, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x47,
+	0x52, 0x41, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x72, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x10, 0x02, 0x12,
+	0x1b, 0x0a, 0x17, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55,
+	0x4c, 0x54, 0x49, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x03, 0x32, 0xef, 0x12, 0x0a,
+	0x0c, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x53, 0x76, 0x63, 0x12, 0x40, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x34, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
+	0x10, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x15, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x19,
+	0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x1a, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x15, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x14, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x15,
+	0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x19, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a,
+	0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x10, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x10, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x12, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x55, 0x6e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x16,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x10,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x0f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x40, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x31, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x1a, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x0d, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x2e, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x0e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x53,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x19,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x13,
+	0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x13, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0a, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x11, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x15, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x0e,
+	0x5a, 0x0c, 0x2e, 0x2f, 0x3b, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_messenger_proto_rawDescOnce sync.Once
+	file_messenger_proto_rawDescData = file_messenger_proto_rawDesc
+)
+
+func file_messenger_proto_rawDescGZIP() []byte {
+	file_messenger_proto_rawDescOnce.Do(func() {
+		file_messenger_proto_rawDescData = protoimpl.X.CompressGZIP(file_messenger_proto_rawDescData)
+	})
+	return file_messenger_proto_rawDescData
+}
+
+var file_messenger_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_messenger_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
+var file_messenger_proto_goTypes = []interface{}{
+	(OutgoingRequestStatus)(0),                                    // 0: OutgoingRequestStatus
+	(ContactRequestEventType)(0),                                  // 1: ContactRequestEventType
+	(ContactAction)(0),                                            // 2: ContactAction
+	(ContactState)(0),                                             // 3: ContactState
+	(ContactDirection)(0),                                         // 4: ContactDirection
+	(ListOrder)(0),                                                // 5: ListOrder
+	(MessageFormat)(0),                                            // 6: MessageFormat
+	(AppMessageType)(0),                                           // 7: AppMessageType
+	(GroupMemberEventType)(0),                                     // 8: GroupMemberEventType
+	(GroupType)(0),                                                // 9: GroupType
+	(*GetContactPubkeyReq)(nil),                                   // 10: GetContactPubkeyReq
+	(*GetContactPubkeyRes)(nil),                                   // 11: GetContactPubkeyRes
+	(*ShareContactReq)(nil),                                       // 12: ShareContactReq
+	(*ShareContactRes)(nil),                                       // 13: ShareContactRes
+	(*EnableContactRequestsReq)(nil),                              // 14: EnableContactRequestsReq
+	(*EnableContactRequestsRes)(nil),                              // 15: EnableContactRequestsRes
+	(*DisableContactRequestsReq)(nil),                             // 16: DisableContactRequestsReq
+	(*DisableContactRequestsRes)(nil),                             // 17: DisableContactRequestsRes
+	(*ResetContactReferenceReq)(nil),                              // 18: ResetContactReferenceReq
+	(*ResetContactReferenceRes)(nil),                              // 19: ResetContactReferenceRes
+	(*GetContactRequestsReq)(nil),                                 // 20: GetContactRequestsReq
+	(*GetContactRequestsRes)(nil),                                 // 21: GetContactRequestsRes
+	(*SendContactRequestReq)(nil),                                 // 22: SendContactRequestReq
+	(*SendContactRequestRes)(nil),                                 // 23: SendContactRequestRes
+	(*ListOutgoingContactRequestsReq)(nil),                        // 24: ListOutgoingContactRequestsReq
+	(*ListOutgoingContactRequestsRes)(nil),                        // 25: ListOutgoingContactRequestsRes
+	(*AcceptContactRequestReq)(nil),                               // 26: AcceptContactRequestReq
+	(*AcceptContactRequestRes)(nil),                               // 27: AcceptContactRequestRes
+	(*DiscardContactRequestReq)(nil),                              // 28: DiscardContactRequestReq
+	(*DiscardContactRequestRes)(nil),                              // 29: DiscardContactRequestRes
+	(*BlockContactReq)(nil),                                       // 30: BlockContactReq
+	(*BlockContactRes)(nil),                                       // 31: BlockContactRes
+	(*UnblockContactReq)(nil),                                     // 32: UnblockContactReq
+	(*UnblockContactRes)(nil),                                     // 33: UnblockContactRes
+	(*GetSafetyNumberReq)(nil),                                    // 34: GetSafetyNumberReq
+	(*GetSafetyNumberRes)(nil),                                    // 35: GetSafetyNumberRes
+	(*SetContactVerifiedReq)(nil),                                 // 36: SetContactVerifiedReq
+	(*SetContactVerifiedRes)(nil),                                 // 37: SetContactVerifiedRes
+	(*ContactEntry)(nil),                                          // 38: ContactEntry
+	(*SetContactEntryReq)(nil),                                    // 39: SetContactEntryReq
+	(*SetContactEntryRes)(nil),                                    // 40: SetContactEntryRes
+	(*GetContactEntryReq)(nil),                                    // 41: GetContactEntryReq
+	(*GetContactEntryRes)(nil),                                    // 42: GetContactEntryRes
+	(*ListContactEntriesReq)(nil),                                 // 43: ListContactEntriesReq
+	(*ListContactEntriesRes)(nil),                                 // 44: ListContactEntriesRes
+	(*DeleteContactEntryReq)(nil),                                 // 45: DeleteContactEntryReq
+	(*DeleteContactEntryRes)(nil),                                 // 46: DeleteContactEntryRes
+	(*ListContactsReq)(nil),                                       // 47: ListContactsReq
+	(*ListContactsRes)(nil),                                       // 48: ListContactsRes
+	(*Contact)(nil),                                               // 49: Contact
+	(*WatchContactRequestsReq)(nil),                               // 50: WatchContactRequestsReq
+	(*ContactRequestEvent)(nil),                                   // 51: ContactRequestEvent
+	(*ListContactDecisionsReq)(nil),                               // 52: ListContactDecisionsReq
+	(*ListContactDecisionsRes)(nil),                               // 53: ListContactDecisionsRes
+	(*ContactDecision)(nil),                                       // 54: ContactDecision
+	(*ContactCard)(nil),                                           // 55: ContactCard
+	(*SendMessageReq)(nil),                                        // 56: SendMessageReq
+	(*SendMessageRes)(nil),                                        // 57: SendMessageRes
+	(*ListMessagesReq)(nil),                                       // 58: ListMessagesReq
+	(*ListMessagesRes)(nil),                                       // 59: ListMessagesRes
+	(*SubscribeMessagesReq)(nil),                                  // 60: SubscribeMessagesReq
+	(*CreateGroupReq)(nil),                                        // 61: CreateGroupReq
+	(*CreateGroupRes)(nil),                                        // 62: CreateGroupRes
+	(*JoinGroupReq)(nil),                                          // 63: JoinGroupReq
+	(*JoinGroupRes)(nil),                                          // 64: JoinGroupRes
+	(*ListGroupsReq)(nil),                                         // 65: ListGroupsReq
+	(*ListGroupsRes)(nil),                                         // 66: ListGroupsRes
+	(*Group)(nil),                                                 // 67: Group
+	(*LeaveGroupReq)(nil),                                         // 68: LeaveGroupReq
+	(*LeaveGroupRes)(nil),                                         // 69: LeaveGroupRes
+	(*ListGroupMembersReq)(nil),                                   // 70: ListGroupMembersReq
+	(*ListGroupMembersRes)(nil),                                   // 71: ListGroupMembersRes
+	(*GroupMember)(nil),                                           // 72: GroupMember
+	(*WatchGroupMembersReq)(nil),                                  // 73: WatchGroupMembersReq
+	(*GroupMemberEvent)(nil),                                      // 74: GroupMemberEvent
+	(*GroupProfile)(nil),                                          // 75: GroupProfile
+	(*UpdateGroupProfileReq)(nil),                                 // 76: UpdateGroupProfileReq
+	(*UpdateGroupProfileRes)(nil),                                 // 77: UpdateGroupProfileRes
+	(*GetGroupProfileReq)(nil),                                    // 78: GetGroupProfileReq
+	(*GetGroupProfileRes)(nil),                                    // 79: GetGroupProfileRes
+	(*GroupSettings)(nil),                                         // 80: GroupSettings
+	(*SetGroupSettingsReq)(nil),                                   // 81: SetGroupSettingsReq
+	(*SetGroupSettingsRes)(nil),                                   // 82: SetGroupSettingsRes
+	(*CreateGroupInvitationReq)(nil),                              // 83: CreateGroupInvitationReq
+	(*CreateGroupInvitationRes)(nil),                              // 84: CreateGroupInvitationRes
+	(*GrantGroupAdminReq)(nil),                                    // 85: GrantGroupAdminReq
+	(*GrantGroupAdminRes)(nil),                                    // 86: GrantGroupAdminRes
+	(*ListGroupAdminsReq)(nil),                                    // 87: ListGroupAdminsReq
+	(*ListGroupAdminsRes)(nil),                                    // 88: ListGroupAdminsRes
+	(*AppMetadata)(nil),                                           // 89: AppMetadata
+	(*Envelope)(nil),                                              // 90: Envelope
+	(*GetContactRequestsRes_ContactRequest)(nil),                  // 91: GetContactRequestsRes.ContactRequest
+	(*ListOutgoingContactRequestsRes_OutgoingContactRequest)(nil), // 92: ListOutgoingContactRequestsRes.OutgoingContactRequest
+	nil, // 93: ContactCard.AttributesEntry
+}
+var file_messenger_proto_depIdxs = []int32{
+	91, // 0: GetContactRequestsRes.contact_requests:type_name -> GetContactRequestsRes.ContactRequest
+	55, // 1: SendContactRequestReq.card:type_name -> ContactCard
+	0,  // 2: SendContactRequestRes.status:type_name -> OutgoingRequestStatus
+	92, // 3: ListOutgoingContactRequestsRes.requests:type_name -> ListOutgoingContactRequestsRes.OutgoingContactRequest
+	38, // 4: SetContactEntryReq.entry:type_name -> ContactEntry
+	38, // 5: GetContactEntryRes.entry:type_name -> ContactEntry
+	38, // 6: ListContactEntriesRes.entries:type_name -> ContactEntry
+	49, // 7: ListContactsRes.contacts:type_name -> Contact
+	3,  // 8: Contact.state:type_name -> ContactState
+	4,  // 9: Contact.direction:type_name -> ContactDirection
+	55, // 10: Contact.card:type_name -> ContactCard
+	1,  // 11: ContactRequestEvent.type:type_name -> ContactRequestEventType
+	49, // 12: ContactRequestEvent.contact:type_name -> Contact
+	54, // 13: ListContactDecisionsRes.decisions:type_name -> ContactDecision
+	2,  // 14: ContactDecision.action:type_name -> ContactAction
+	93, // 15: ContactCard.attributes:type_name -> ContactCard.AttributesEntry
+	6,  // 16: SendMessageReq.format:type_name -> MessageFormat
+	7,  // 17: SendMessageReq.app_message_type:type_name -> AppMessageType
+	6,  // 18: ListMessagesReq.format:type_name -> MessageFormat
+	5,  // 19: ListMessagesReq.order:type_name -> ListOrder
+	7,  // 20: ListMessagesRes.app_message_type:type_name -> AppMessageType
+	6,  // 21: SubscribeMessagesReq.format:type_name -> MessageFormat
+	67, // 22: ListGroupsRes.groups:type_name -> Group
+	9,  // 23: Group.type:type_name -> GroupType
+	75, // 24: Group.profile:type_name -> GroupProfile
+	72, // 25: ListGroupMembersRes.members:type_name -> GroupMember
+	8,  // 26: GroupMemberEvent.type:type_name -> GroupMemberEventType
+	72, // 27: GroupMemberEvent.member:type_name -> GroupMember
+	75, // 28: UpdateGroupProfileReq.profile:type_name -> GroupProfile
+	75, // 29: UpdateGroupProfileRes.profile:type_name -> GroupProfile
+	75, // 30: GetGroupProfileRes.profile:type_name -> GroupProfile
+	80, // 31: GetGroupProfileRes.settings:type_name -> GroupSettings
+	80, // 32: SetGroupSettingsReq.settings:type_name -> GroupSettings
+	72, // 33: ListGroupAdminsRes.admins:type_name -> GroupMember
+	75, // 34: AppMetadata.group_profile:type_name -> GroupProfile
+	80, // 35: AppMetadata.group_settings:type_name -> GroupSettings
+	55, // 36: GetContactRequestsRes.ContactRequest.card:type_name -> ContactCard
+	0,  // 37: ListOutgoingContactRequestsRes.OutgoingContactRequest.status:type_name -> OutgoingRequestStatus
+	10, // 38: MessengerSvc.GetContactPubkey:input_type -> GetContactPubkeyReq
+	12, // 39: MessengerSvc.ShareContact:input_type -> ShareContactReq
+	14, // 40: MessengerSvc.EnableContactRequests:input_type -> EnableContactRequestsReq
+	16, // 41: MessengerSvc.DisableContactRequests:input_type -> DisableContactRequestsReq
+	18, // 42: MessengerSvc.ResetContactReference:input_type -> ResetContactReferenceReq
+	20, // 43: MessengerSvc.GetContactRequests:input_type -> GetContactRequestsReq
+	22, // 44: MessengerSvc.SendContactRequest:input_type -> SendContactRequestReq
+	26, // 45: MessengerSvc.AcceptContactRequest:input_type -> AcceptContactRequestReq
+	24, // 46: MessengerSvc.ListOutgoingContactRequests:input_type -> ListOutgoingContactRequestsReq
+	28, // 47: MessengerSvc.DiscardContactRequest:input_type -> DiscardContactRequestReq
+	30, // 48: MessengerSvc.BlockContact:input_type -> BlockContactReq
+	32, // 49: MessengerSvc.UnblockContact:input_type -> UnblockContactReq
+	50, // 50: MessengerSvc.WatchContactRequests:input_type -> WatchContactRequestsReq
+	52, // 51: MessengerSvc.ListContactDecisions:input_type -> ListContactDecisionsReq
+	34, // 52: MessengerSvc.GetSafetyNumber:input_type -> GetSafetyNumberReq
+	36, // 53: MessengerSvc.SetContactVerified:input_type -> SetContactVerifiedReq
+	39, // 54: MessengerSvc.SetContactEntry:input_type -> SetContactEntryReq
+	41, // 55: MessengerSvc.GetContactEntry:input_type -> GetContactEntryReq
+	43, // 56: MessengerSvc.ListContactEntries:input_type -> ListContactEntriesReq
+	45, // 57: MessengerSvc.DeleteContactEntry:input_type -> DeleteContactEntryReq
+	47, // 58: MessengerSvc.ListContacts:input_type -> ListContactsReq
+	56, // 59: MessengerSvc.SendMessage:input_type -> SendMessageReq
+	58, // 60: MessengerSvc.ListMessages:input_type -> ListMessagesReq
+	60, // 61: MessengerSvc.SubscribeMessages:input_type -> SubscribeMessagesReq
+	61, // 62: MessengerSvc.CreateGroup:input_type -> CreateGroupReq
+	63, // 63: MessengerSvc.JoinGroup:input_type -> JoinGroupReq
+	65, // 64: MessengerSvc.ListGroups:input_type -> ListGroupsReq
+	76, // 65: MessengerSvc.UpdateGroupProfile:input_type -> UpdateGroupProfileReq
+	78, // 66: MessengerSvc.GetGroupProfile:input_type -> GetGroupProfileReq
+	81, // 67: MessengerSvc.SetGroupSettings:input_type -> SetGroupSettingsReq
+	83, // 68: MessengerSvc.CreateGroupInvitation:input_type -> CreateGroupInvitationReq
+	85, // 69: MessengerSvc.GrantGroupAdmin:input_type -> GrantGroupAdminReq
+	87, // 70: MessengerSvc.ListGroupAdmins:input_type -> ListGroupAdminsReq
+	68, // 71: MessengerSvc.LeaveGroup:input_type -> LeaveGroupReq
+	70, // 72: MessengerSvc.ListGroupMembers:input_type -> ListGroupMembersReq
+	73, // 73: MessengerSvc.WatchGroupMembers:input_type -> WatchGroupMembersReq
+	11, // 74: MessengerSvc.GetContactPubkey:output_type -> GetContactPubkeyRes
+	13, // 75: MessengerSvc.ShareContact:output_type -> ShareContactRes
+	15, // 76: MessengerSvc.EnableContactRequests:output_type -> EnableContactRequestsRes
+	17, // 77: MessengerSvc.DisableContactRequests:output_type -> DisableContactRequestsRes
+	19, // 78: MessengerSvc.ResetContactReference:output_type -> ResetContactReferenceRes
+	21, // 79: MessengerSvc.GetContactRequests:output_type -> GetContactRequestsRes
+	23, // 80: MessengerSvc.SendContactRequest:output_type -> SendContactRequestRes
+	27, // 81: MessengerSvc.AcceptContactRequest:output_type -> AcceptContactRequestRes
+	25, // 82: MessengerSvc.ListOutgoingContactRequests:output_type -> ListOutgoingContactRequestsRes
+	29, // 83: MessengerSvc.DiscardContactRequest:output_type -> DiscardContactRequestRes
+	31, // 84: MessengerSvc.BlockContact:output_type -> BlockContactRes
+	33, // 85: MessengerSvc.UnblockContact:output_type -> UnblockContactRes
+	51, // 86: MessengerSvc.WatchContactRequests:output_type -> ContactRequestEvent
+	53, // 87: MessengerSvc.ListContactDecisions:output_type -> ListContactDecisionsRes
+	35, // 88: MessengerSvc.GetSafetyNumber:output_type -> GetSafetyNumberRes
+	37, // 89: MessengerSvc.SetContactVerified:output_type -> SetContactVerifiedRes
+	40, // 90: MessengerSvc.SetContactEntry:output_type -> SetContactEntryRes
+	42, // 91: MessengerSvc.GetContactEntry:output_type -> GetContactEntryRes
+	44, // 92: MessengerSvc.ListContactEntries:output_type -> ListContactEntriesRes
+	46, // 93: MessengerSvc.DeleteContactEntry:output_type -> DeleteContactEntryRes
+	48, // 94: MessengerSvc.ListContacts:output_type -> ListContactsRes
+	57, // 95: MessengerSvc.SendMessage:output_type -> SendMessageRes
+	59, // 96: MessengerSvc.ListMessages:output_type -> ListMessagesRes
+	59, // 97: MessengerSvc.SubscribeMessages:output_type -> ListMessagesRes
+	62, // 98: MessengerSvc.CreateGroup:output_type -> CreateGroupRes
+	64, // 99: MessengerSvc.JoinGroup:output_type -> JoinGroupRes
+	66, // 100: MessengerSvc.ListGroups:output_type -> ListGroupsRes
+	77, // 101: MessengerSvc.UpdateGroupProfile:output_type -> UpdateGroupProfileRes
+	79, // 102: MessengerSvc.GetGroupProfile:output_type -> GetGroupProfileRes
+	82, // 103: MessengerSvc.SetGroupSettings:output_type -> SetGroupSettingsRes
+	84, // 104: MessengerSvc.CreateGroupInvitation:output_type -> CreateGroupInvitationRes
+	86, // 105: MessengerSvc.GrantGroupAdmin:output_type -> GrantGroupAdminRes
+	88, // 106: MessengerSvc.ListGroupAdmins:output_type -> ListGroupAdminsRes
+	69, // 107: MessengerSvc.LeaveGroup:output_type -> LeaveGroupRes
+	71, // 108: MessengerSvc.ListGroupMembers:output_type -> ListGroupMembersRes
+	74, // 109: MessengerSvc.WatchGroupMembers:output_type -> GroupMemberEvent
+	74, // [74:110] is the sub-list for method output_type
+	38, // [38:74] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_messenger_proto_init() }
+func file_messenger_proto_init() {
+	if File_messenger_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_messenger_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactPubkeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactPubkeyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareContactReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareContactRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableContactRequestsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableContactRequestsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableContactRequestsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableContactRequestsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetContactReferenceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetContactReferenceRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactRequestsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactRequestsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendContactRequestReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendContactRequestRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutgoingContactRequestsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutgoingContactRequestsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptContactRequestReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptContactRequestRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardContactRequestReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardContactRequestRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockContactReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockContactRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockContactReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockContactRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSafetyNumberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSafetyNumberRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContactVerifiedReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContactVerifiedRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContactEntryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetContactEntryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactEntryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactEntryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContactEntriesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContactEntriesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteContactEntryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteContactEntryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContactsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContactsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchContactRequestsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactRequestEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContactDecisionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContactDecisionsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactCard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMessageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMessageRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMessagesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMessagesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMessagesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinGroupRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveGroupRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupMembersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupMembersRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use JoinGroupRes.ProtoReflect.Descriptor instead.
-func (*JoinGroupRes) Descriptor() ([]byte, []int) {
-	return file_messenger_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *JoinGroupRes) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *JoinGroupRes) GetGroupPk() string {
-	if x != nil {
-		return x.GroupPk
-	}
-	return ""
-}
-
-type GetContactRequestsRes_ContactRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-}
-
-func (x *GetContactRequestsRes_ContactRequest) Reset() {
-	*x = GetContactRequestsRes_ContactRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_messenger_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetContactRequestsRes_ContactRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetContactRequestsRes_ContactRequest) ProtoMessage() {}
-
-func (x *GetContactRequestsRes_ContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messenger_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_messenger_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetContactRequestsRes_ContactRequest.ProtoReflect.Descriptor instead.
-func (*GetContactRequestsRes_ContactRequest) Descriptor() ([]byte, []int) {
-	return file_messenger_proto_rawDescGZIP(), []int{3, 0}
-}
-
-func (x *GetContactRequestsRes_ContactRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GetContactRequestsRes_ContactRequest) GetPublicKey() string {
-	if x != nil {
-		return x.PublicKey
-	}
-	return ""
-}
-
-var File_messenger_proto protoreflect.FileDescriptor
-
-var file_messenger_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50,
-	0x75, 0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x64, 0x76, 0x53, 0x65,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x64, 0x76, 0x53, 0x65, 0x65,
-	0x64, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x22, 0xae, 0x01, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x43, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x5d, 0x0a, 0x15, 0x53,
-	0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x64, 0x76, 0x53, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
-	0x64, 0x76, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x53, 0x65,
-	0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x31, 0x0a,
-	0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x22, 0x33, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x60, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x2a, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0x47, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c,
-	0x0a, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x3b, 0x0a, 0x0f,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x22, 0x54, 0x0a, 0x0e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x38, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x0c, 0x4a,
-	0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6b, 0x32,
-	0xf9, 0x03, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x53, 0x76, 0x63,
-	0x12, 0x40, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73,
-	0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x53, 0x65,
-	0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00,
-	0x12, 0x31, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x0f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x0f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x0b, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2b,
-	0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0d, 0x2e, 0x4a, 0x6f,
-	0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x4a, 0x6f, 0x69,
-	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x22, 0x00, 0x42, 0x0e, 0x5a, 0x0c, 0x2e,
-	0x2f, 0x3b, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_messenger_proto_rawDescOnce sync.Once
-	file_messenger_proto_rawDescData = file_messenger_proto_rawDesc
-)
-
-func file_messenger_proto_rawDescGZIP() []byte {
-	file_messenger_proto_rawDescOnce.Do(func() {
-		file_messenger_proto_rawDescData = protoimpl.X.CompressGZIP(file_messenger_proto_rawDescData)
-	})
-	return file_messenger_proto_rawDescData
-}
-
-var file_messenger_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_messenger_proto_goTypes = []interface{}{
-	(*GetContactPubkeyReq)(nil),                  // 0: GetContactPubkeyReq
-	(*GetContactPubkeyRes)(nil),                  // 1: GetContactPubkeyRes
-	(*GetContactRequestsReq)(nil),                // 2: GetContactRequestsReq
-	(*GetContactRequestsRes)(nil),                // 3: GetContactRequestsRes
-	(*SendContactRequestReq)(nil),                // 4: SendContactRequestReq
-	(*SendContactRequestRes)(nil),                // 5: SendContactRequestRes
-	(*AcceptContactRequestReq)(nil),              // 6: AcceptContactRequestReq
-	(*AcceptContactRequestRes)(nil),              // 7: AcceptContactRequestRes
-	(*SendMessageReq)(nil),                       // 8: SendMessageReq
-	(*SendMessageRes)(nil),                       // 9: SendMessageRes
-	(*ListMessagesReq)(nil),                      // 10: ListMessagesReq
-	(*ListMessagesRes)(nil),                      // 11: ListMessagesRes
-	(*CreateGroupReq)(nil),                       // 12: CreateGroupReq
-	(*CreateGroupRes)(nil),                       // 13: CreateGroupRes
-	(*JoinGroupReq)(nil),                         // 14: JoinGroupReq
-	(*JoinGroupRes)(nil),                         // 15: JoinGroupRes
-	(*GetContactRequestsRes_ContactRequest)(nil), // 16: GetContactRequestsRes.ContactRequest
-}
-var file_messenger_proto_depIdxs = []int32{
-	16, // 0: GetContactRequestsRes.contact_requests:type_name -> GetContactRequestsRes.ContactRequest
-	0,  // 1: MessengerSvc.GetContactPubkey:input_type -> GetContactPubkeyReq
-	2,  // 2: MessengerSvc.GetContactRequests:input_type -> GetContactRequestsReq
-	4,  // 3: MessengerSvc.SendContactRequest:input_type -> SendContactRequestReq
-	6,  // 4: MessengerSvc.AcceptContactRequest:input_type -> AcceptContactRequestReq
-	8,  // 5: MessengerSvc.SendMessage:input_type -> SendMessageReq
-	10, // 6: MessengerSvc.ListMessages:input_type -> ListMessagesReq
-	12, // 7: MessengerSvc.CreateGroup:input_type -> CreateGroupReq
-	14, // 8: MessengerSvc.JoinGroup:input_type -> JoinGroupReq
-	1,  // 9: MessengerSvc.GetContactPubkey:output_type -> GetContactPubkeyRes
-	3,  // 10: MessengerSvc.GetContactRequests:output_type -> GetContactRequestsRes
-	5,  // 11: MessengerSvc.SendContactRequest:output_type -> SendContactRequestRes
-	7,  // 12: MessengerSvc.AcceptContactRequest:output_type -> AcceptContactRequestRes
-	9,  // 13: MessengerSvc.SendMessage:output_type -> SendMessageRes
-	11, // 14: MessengerSvc.ListMessages:output_type -> ListMessagesRes
-	13, // 15: MessengerSvc.CreateGroup:output_type -> CreateGroupRes
-	15, // 16: MessengerSvc.JoinGroup:output_type -> JoinGroupRes
-	9,  // [9:17] is the sub-list for method output_type
-	1,  // [1:9] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
-}
-
-func init() { file_messenger_proto_init() }
-func file_messenger_proto_init() {
-	if File_messenger_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_messenger_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactPubkeyReq); i {
+		file_messenger_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchGroupMembersReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1053,8 +7072,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactPubkeyRes); i {
+		file_messenger_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMemberEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1065,8 +7084,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactRequestsReq); i {
+		file_messenger_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1077,8 +7096,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactRequestsRes); i {
+		file_messenger_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupProfileReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1089,8 +7108,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendContactRequestReq); i {
+		file_messenger_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupProfileRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1101,8 +7120,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendContactRequestRes); i {
+		file_messenger_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupProfileReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1113,8 +7132,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptContactRequestReq); i {
+		file_messenger_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupProfileRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1125,8 +7144,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptContactRequestRes); i {
+		file_messenger_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1137,8 +7156,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMessageReq); i {
+		file_messenger_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupSettingsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1149,8 +7168,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMessageRes); i {
+		file_messenger_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGroupSettingsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1161,8 +7180,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMessagesReq); i {
+		file_messenger_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupInvitationReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1173,8 +7192,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMessagesRes); i {
+		file_messenger_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupInvitationRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1185,8 +7204,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGroupReq); i {
+		file_messenger_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantGroupAdminReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1197,8 +7216,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGroupRes); i {
+		file_messenger_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantGroupAdminRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1209,8 +7228,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinGroupReq); i {
+		file_messenger_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupAdminsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1221,8 +7240,8 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinGroupRes); i {
+		file_messenger_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupAdminsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1233,7 +7252,31 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
-		file_messenger_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_messenger_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messenger_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetContactRequestsRes_ContactRequest); i {
 			case 0:
 				return &v.state
@@ -1245,19 +7288,36 @@ func file_messenger_proto_init() {
 				return nil
 			}
 		}
+		file_messenger_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutgoingContactRequestsRes_OutgoingContactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_messenger_proto_msgTypes[79].OneofWrappers = []interface{}{
+		(*AppMetadata_GroupProfile)(nil),
+		(*AppMetadata_GroupSettings)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_messenger_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      10,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_messenger_proto_goTypes,
 		DependencyIndexes: file_messenger_proto_depIdxs,
+		EnumInfos:         file_messenger_proto_enumTypes,
 		MessageInfos:      file_messenger_proto_msgTypes,
 	}.Build()
 	File_messenger_proto = out.File
@@ -23,13 +23,41 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MessengerSvcClient interface {
 	GetContactPubkey(ctx context.Context, in *GetContactPubkeyReq, opts ...grpc.CallOption) (*GetContactPubkeyRes, error)
+	ShareContact(ctx context.Context, in *ShareContactReq, opts ...grpc.CallOption) (*ShareContactRes, error)
+	EnableContactRequests(ctx context.Context, in *EnableContactRequestsReq, opts ...grpc.CallOption) (*EnableContactRequestsRes, error)
+	DisableContactRequests(ctx context.Context, in *DisableContactRequestsReq, opts ...grpc.CallOption) (*DisableContactRequestsRes, error)
+	ResetContactReference(ctx context.Context, in *ResetContactReferenceReq, opts ...grpc.CallOption) (*ResetContactReferenceRes, error)
 	GetContactRequests(ctx context.Context, in *GetContactRequestsReq, opts ...grpc.CallOption) (*GetContactRequestsRes, error)
 	SendContactRequest(ctx context.Context, in *SendContactRequestReq, opts ...grpc.CallOption) (*SendContactRequestRes, error)
 	AcceptContactRequest(ctx context.Context, in *AcceptContactRequestReq, opts ...grpc.CallOption) (*AcceptContactRequestRes, error)
+	ListOutgoingContactRequests(ctx context.Context, in *ListOutgoingContactRequestsReq, opts ...grpc.CallOption) (*ListOutgoingContactRequestsRes, error)
+	DiscardContactRequest(ctx context.Context, in *DiscardContactRequestReq, opts ...grpc.CallOption) (*DiscardContactRequestRes, error)
+	BlockContact(ctx context.Context, in *BlockContactReq, opts ...grpc.CallOption) (*BlockContactRes, error)
+	UnblockContact(ctx context.Context, in *UnblockContactReq, opts ...grpc.CallOption) (*UnblockContactRes, error)
+	WatchContactRequests(ctx context.Context, in *WatchContactRequestsReq, opts ...grpc.CallOption) (MessengerSvc_WatchContactRequestsClient, error)
+	ListContactDecisions(ctx context.Context, in *ListContactDecisionsReq, opts ...grpc.CallOption) (*ListContactDecisionsRes, error)
+	GetSafetyNumber(ctx context.Context, in *GetSafetyNumberReq, opts ...grpc.CallOption) (*GetSafetyNumberRes, error)
+	SetContactVerified(ctx context.Context, in *SetContactVerifiedReq, opts ...grpc.CallOption) (*SetContactVerifiedRes, error)
+	SetContactEntry(ctx context.Context, in *SetContactEntryReq, opts ...grpc.CallOption) (*SetContactEntryRes, error)
+	GetContactEntry(ctx context.Context, in *GetContactEntryReq, opts ...grpc.CallOption) (*GetContactEntryRes, error)
+	ListContactEntries(ctx context.Context, in *ListContactEntriesReq, opts ...grpc.CallOption) (*ListContactEntriesRes, error)
+	DeleteContactEntry(ctx context.Context, in *DeleteContactEntryReq, opts ...grpc.CallOption) (*DeleteContactEntryRes, error)
+	ListContacts(ctx context.Context, in *ListContactsReq, opts ...grpc.CallOption) (*ListContactsRes, error)
 	SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageRes, error)
 	ListMessages(ctx context.Context, in *ListMessagesReq, opts ...grpc.CallOption) (MessengerSvc_ListMessagesClient, error)
+	SubscribeMessages(ctx context.Context, in *SubscribeMessagesReq, opts ...grpc.CallOption) (MessengerSvc_SubscribeMessagesClient, error)
 	CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupRes, error)
 	JoinGroup(ctx context.Context, in *JoinGroupReq, opts ...grpc.CallOption) (*JoinGroupRes, error)
+	ListGroups(ctx context.Context, in *ListGroupsReq, opts ...grpc.CallOption) (*ListGroupsRes, error)
+	UpdateGroupProfile(ctx context.Context, in *UpdateGroupProfileReq, opts ...grpc.CallOption) (*UpdateGroupProfileRes, error)
+	GetGroupProfile(ctx context.Context, in *GetGroupProfileReq, opts ...grpc.CallOption) (*GetGroupProfileRes, error)
+	SetGroupSettings(ctx context.Context, in *SetGroupSettingsReq, opts ...grpc.CallOption) (*SetGroupSettingsRes, error)
+	CreateGroupInvitation(ctx context.Context, in *CreateGroupInvitationReq, opts ...grpc.CallOption) (*CreateGroupInvitationRes, error)
+	GrantGroupAdmin(ctx context.Context, in *GrantGroupAdminReq, opts ...grpc.CallOption) (*GrantGroupAdminRes, error)
+	ListGroupAdmins(ctx context.Context, in *ListGroupAdminsReq, opts ...grpc.CallOption) (*ListGroupAdminsRes, error)
+	LeaveGroup(ctx context.Context, in *LeaveGroupReq, opts ...grpc.CallOption) (*LeaveGroupRes, error)
+	ListGroupMembers(ctx context.Context, in *ListGroupMembersReq, opts ...grpc.CallOption) (*ListGroupMembersRes, error)
+	WatchGroupMembers(ctx context.Context, in *WatchGroupMembersReq, opts ...grpc.CallOption) (MessengerSvc_WatchGroupMembersClient, error)
 }
 
 type messengerSvcClient struct {
@@ -49,6 +77,42 @@ func (c *messengerSvcClient) GetContactPubkey(ctx context.Context, in *GetContac
 	return out, nil
 }
 
+func (c *messengerSvcClient) ShareContact(ctx context.Context, in *ShareContactReq, opts ...grpc.CallOption) (*ShareContactRes, error) {
+	out := new(ShareContactRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/ShareContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) EnableContactRequests(ctx context.Context, in *EnableContactRequestsReq, opts ...grpc.CallOption) (*EnableContactRequestsRes, error) {
+	out := new(EnableContactRequestsRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/EnableContactRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) DisableContactRequests(ctx context.Context, in *DisableContactRequestsReq, opts ...grpc.CallOption) (*DisableContactRequestsRes, error) {
+	out := new(DisableContactRequestsRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/DisableContactRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) ResetContactReference(ctx context.Context, in *ResetContactReferenceReq, opts ...grpc.CallOption) (*ResetContactReferenceRes, error) {
+	out := new(ResetContactReferenceRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/ResetContactReference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messengerSvcClient) GetContactRequests(ctx context.Context, in *GetContactRequestsReq, opts ...grpc.CallOption) (*GetContactRequestsRes, error) {
 	out := new(GetContactRequestsRes)
 	err := c.cc.Invoke(ctx, "/MessengerSvc/GetContactRequests", in, out, opts...)
@@ -76,6 +140,146 @@ func (c *messengerSvcClient) AcceptContactRequest(ctx context.Context, in *Accep
 	return out, nil
 }
 
+func (c *messengerSvcClient) ListOutgoingContactRequests(ctx context.Context, in *ListOutgoingContactRequestsReq, opts ...grpc.CallOption) (*ListOutgoingContactRequestsRes, error) {
+	out := new(ListOutgoingContactRequestsRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/ListOutgoingContactRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) DiscardContactRequest(ctx context.Context, in *DiscardContactRequestReq, opts ...grpc.CallOption) (*DiscardContactRequestRes, error) {
+	out := new(DiscardContactRequestRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/DiscardContactRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) BlockContact(ctx context.Context, in *BlockContactReq, opts ...grpc.CallOption) (*BlockContactRes, error) {
+	out := new(BlockContactRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/BlockContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) UnblockContact(ctx context.Context, in *UnblockContactReq, opts ...grpc.CallOption) (*UnblockContactRes, error) {
+	out := new(UnblockContactRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/UnblockContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) WatchContactRequests(ctx context.Context, in *WatchContactRequestsReq, opts ...grpc.CallOption) (MessengerSvc_WatchContactRequestsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MessengerSvc_ServiceDesc.Streams[0], "/MessengerSvc/WatchContactRequests", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &messengerSvcWatchContactRequestsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MessengerSvc_WatchContactRequestsClient interface {
+	Recv() (*ContactRequestEvent, error)
+	grpc.ClientStream
+}
+
+type messengerSvcWatchContactRequestsClient struct {
+	grpc.ClientStream
+}
+
+func (x *messengerSvcWatchContactRequestsClient) Recv() (*ContactRequestEvent, error) {
+	m := new(ContactRequestEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *messengerSvcClient) ListContactDecisions(ctx context.Context, in *ListContactDecisionsReq, opts ...grpc.CallOption) (*ListContactDecisionsRes, error) {
+	out := new(ListContactDecisionsRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/ListContactDecisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) GetSafetyNumber(ctx context.Context, in *GetSafetyNumberReq, opts ...grpc.CallOption) (*GetSafetyNumberRes, error) {
+	out := new(GetSafetyNumberRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/GetSafetyNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) SetContactVerified(ctx context.Context, in *SetContactVerifiedReq, opts ...grpc.CallOption) (*SetContactVerifiedRes, error) {
+	out := new(SetContactVerifiedRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/SetContactVerified", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) SetContactEntry(ctx context.Context, in *SetContactEntryReq, opts ...grpc.CallOption) (*SetContactEntryRes, error) {
+	out := new(SetContactEntryRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/SetContactEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) GetContactEntry(ctx context.Context, in *GetContactEntryReq, opts ...grpc.CallOption) (*GetContactEntryRes, error) {
+	out := new(GetContactEntryRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/GetContactEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) ListContactEntries(ctx context.Context, in *ListContactEntriesReq, opts ...grpc.CallOption) (*ListContactEntriesRes, error) {
+	out := new(ListContactEntriesRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/ListContactEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) DeleteContactEntry(ctx context.Context, in *DeleteContactEntryReq, opts ...grpc.CallOption) (*DeleteContactEntryRes, error) {
+	out := new(DeleteContactEntryRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/DeleteContactEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) ListContacts(ctx context.Context, in *ListContactsReq, opts ...grpc.CallOption) (*ListContactsRes, error) {
+	out := new(ListContactsRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/ListContacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *messengerSvcClient) SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageRes, error) {
 	out := new(SendMessageRes)
 	err := c.cc.Invoke(ctx, "/MessengerSvc/SendMessage", in, out, opts...)
@@ -86,7 +290,7 @@ func (c *messengerSvcClient) SendMessage(ctx context.Context, in *SendMessageReq
 }
 
 func (c *messengerSvcClient) ListMessages(ctx context.Context, in *ListMessagesReq, opts ...grpc.CallOption) (MessengerSvc_ListMessagesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MessengerSvc_ServiceDesc.Streams[0], "/MessengerSvc/ListMessages", opts...)
+	stream, err := c.cc.NewStream(ctx, &MessengerSvc_ServiceDesc.Streams[1], "/MessengerSvc/ListMessages", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +321,38 @@ func (x *messengerSvcListMessagesClient) Recv() (*ListMessagesRes, error) {
 	return m, nil
 }
 
+func (c *messengerSvcClient) SubscribeMessages(ctx context.Context, in *SubscribeMessagesReq, opts ...grpc.CallOption) (MessengerSvc_SubscribeMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MessengerSvc_ServiceDesc.Streams[2], "/MessengerSvc/SubscribeMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &messengerSvcSubscribeMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MessengerSvc_SubscribeMessagesClient interface {
+	Recv() (*ListMessagesRes, error)
+	grpc.ClientStream
+}
+
+type messengerSvcSubscribeMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *messengerSvcSubscribeMessagesClient) Recv() (*ListMessagesRes, error) {
+	m := new(ListMessagesRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *messengerSvcClient) CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupRes, error) {
 	out := new(CreateGroupRes)
 	err := c.cc.Invoke(ctx, "/MessengerSvc/CreateGroup", in, out, opts...)
@@ -135,18 +371,159 @@ func (c *messengerSvcClient) JoinGroup(ctx context.Context, in *JoinGroupReq, op
 	return out, nil
 }
 
+func (c *messengerSvcClient) ListGroups(ctx context.Context, in *ListGroupsReq, opts ...grpc.CallOption) (*ListGroupsRes, error) {
+	out := new(ListGroupsRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/ListGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) UpdateGroupProfile(ctx context.Context, in *UpdateGroupProfileReq, opts ...grpc.CallOption) (*UpdateGroupProfileRes, error) {
+	out := new(UpdateGroupProfileRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/UpdateGroupProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) GetGroupProfile(ctx context.Context, in *GetGroupProfileReq, opts ...grpc.CallOption) (*GetGroupProfileRes, error) {
+	out := new(GetGroupProfileRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/GetGroupProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) SetGroupSettings(ctx context.Context, in *SetGroupSettingsReq, opts ...grpc.CallOption) (*SetGroupSettingsRes, error) {
+	out := new(SetGroupSettingsRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/SetGroupSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) CreateGroupInvitation(ctx context.Context, in *CreateGroupInvitationReq, opts ...grpc.CallOption) (*CreateGroupInvitationRes, error) {
+	out := new(CreateGroupInvitationRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/CreateGroupInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) GrantGroupAdmin(ctx context.Context, in *GrantGroupAdminReq, opts ...grpc.CallOption) (*GrantGroupAdminRes, error) {
+	out := new(GrantGroupAdminRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/GrantGroupAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) ListGroupAdmins(ctx context.Context, in *ListGroupAdminsReq, opts ...grpc.CallOption) (*ListGroupAdminsRes, error) {
+	out := new(ListGroupAdminsRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/ListGroupAdmins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) LeaveGroup(ctx context.Context, in *LeaveGroupReq, opts ...grpc.CallOption) (*LeaveGroupRes, error) {
+	out := new(LeaveGroupRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/LeaveGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) ListGroupMembers(ctx context.Context, in *ListGroupMembersReq, opts ...grpc.CallOption) (*ListGroupMembersRes, error) {
+	out := new(ListGroupMembersRes)
+	err := c.cc.Invoke(ctx, "/MessengerSvc/ListGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messengerSvcClient) WatchGroupMembers(ctx context.Context, in *WatchGroupMembersReq, opts ...grpc.CallOption) (MessengerSvc_WatchGroupMembersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MessengerSvc_ServiceDesc.Streams[3], "/MessengerSvc/WatchGroupMembers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &messengerSvcWatchGroupMembersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MessengerSvc_WatchGroupMembersClient interface {
+	Recv() (*GroupMemberEvent, error)
+	grpc.ClientStream
+}
+
+type messengerSvcWatchGroupMembersClient struct {
+	grpc.ClientStream
+}
+
+func (x *messengerSvcWatchGroupMembersClient) Recv() (*GroupMemberEvent, error) {
+	m := new(GroupMemberEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // MessengerSvcServer is the server API for MessengerSvc service.
 // All implementations must embed UnimplementedMessengerSvcServer
 // for forward compatibility
 type MessengerSvcServer interface {
 	GetContactPubkey(context.Context, *GetContactPubkeyReq) (*GetContactPubkeyRes, error)
+	ShareContact(context.Context, *ShareContactReq) (*ShareContactRes, error)
+	EnableContactRequests(context.Context, *EnableContactRequestsReq) (*EnableContactRequestsRes, error)
+	DisableContactRequests(context.Context, *DisableContactRequestsReq) (*DisableContactRequestsRes, error)
+	ResetContactReference(context.Context, *ResetContactReferenceReq) (*ResetContactReferenceRes, error)
 	GetContactRequests(context.Context, *GetContactRequestsReq) (*GetContactRequestsRes, error)
 	SendContactRequest(context.Context, *SendContactRequestReq) (*SendContactRequestRes, error)
 	AcceptContactRequest(context.Context, *AcceptContactRequestReq) (*AcceptContactRequestRes, error)
+	ListOutgoingContactRequests(context.Context, *ListOutgoingContactRequestsReq) (*ListOutgoingContactRequestsRes, error)
+	DiscardContactRequest(context.Context, *DiscardContactRequestReq) (*DiscardContactRequestRes, error)
+	BlockContact(context.Context, *BlockContactReq) (*BlockContactRes, error)
+	UnblockContact(context.Context, *UnblockContactReq) (*UnblockContactRes, error)
+	WatchContactRequests(*WatchContactRequestsReq, MessengerSvc_WatchContactRequestsServer) error
+	ListContactDecisions(context.Context, *ListContactDecisionsReq) (*ListContactDecisionsRes, error)
+	GetSafetyNumber(context.Context, *GetSafetyNumberReq) (*GetSafetyNumberRes, error)
+	SetContactVerified(context.Context, *SetContactVerifiedReq) (*SetContactVerifiedRes, error)
+	SetContactEntry(context.Context, *SetContactEntryReq) (*SetContactEntryRes, error)
+	GetContactEntry(context.Context, *GetContactEntryReq) (*GetContactEntryRes, error)
+	ListContactEntries(context.Context, *ListContactEntriesReq) (*ListContactEntriesRes, error)
+	DeleteContactEntry(context.Context, *DeleteContactEntryReq) (*DeleteContactEntryRes, error)
+	ListContacts(context.Context, *ListContactsReq) (*ListContactsRes, error)
 	SendMessage(context.Context, *SendMessageReq) (*SendMessageRes, error)
 	ListMessages(*ListMessagesReq, MessengerSvc_ListMessagesServer) error
+	SubscribeMessages(*SubscribeMessagesReq, MessengerSvc_SubscribeMessagesServer) error
 	CreateGroup(context.Context, *CreateGroupReq) (*CreateGroupRes, error)
 	JoinGroup(context.Context, *JoinGroupReq) (*JoinGroupRes, error)
+	ListGroups(context.Context, *ListGroupsReq) (*ListGroupsRes, error)
+	UpdateGroupProfile(context.Context, *UpdateGroupProfileReq) (*UpdateGroupProfileRes, error)
+	GetGroupProfile(context.Context, *GetGroupProfileReq) (*GetGroupProfileRes, error)
+	SetGroupSettings(context.Context, *SetGroupSettingsReq) (*SetGroupSettingsRes, error)
+	CreateGroupInvitation(context.Context, *CreateGroupInvitationReq) (*CreateGroupInvitationRes, error)
+	GrantGroupAdmin(context.Context, *GrantGroupAdminReq) (*GrantGroupAdminRes, error)
+	ListGroupAdmins(context.Context, *ListGroupAdminsReq) (*ListGroupAdminsRes, error)
+	LeaveGroup(context.Context, *LeaveGroupReq) (*LeaveGroupRes, error)
+	ListGroupMembers(context.Context, *ListGroupMembersReq) (*ListGroupMembersRes, error)
+	WatchGroupMembers(*WatchGroupMembersReq, MessengerSvc_WatchGroupMembersServer) error
 	mustEmbedUnimplementedMessengerSvcServer()
 }
 
@@ -157,6 +534,18 @@ type UnimplementedMessengerSvcServer struct {
 func (UnimplementedMessengerSvcServer) GetContactPubkey(context.Context, *GetContactPubkeyReq) (*GetContactPubkeyRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContactPubkey not implemented")
 }
+func (UnimplementedMessengerSvcServer) ShareContact(context.Context, *ShareContactReq) (*ShareContactRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareContact not implemented")
+}
+func (UnimplementedMessengerSvcServer) EnableContactRequests(context.Context, *EnableContactRequestsReq) (*EnableContactRequestsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableContactRequests not implemented")
+}
+func (UnimplementedMessengerSvcServer) DisableContactRequests(context.Context, *DisableContactRequestsReq) (*DisableContactRequestsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableContactRequests not implemented")
+}
+func (UnimplementedMessengerSvcServer) ResetContactReference(context.Context, *ResetContactReferenceReq) (*ResetContactReferenceRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetContactReference not implemented")
+}
 func (UnimplementedMessengerSvcServer) GetContactRequests(context.Context, *GetContactRequestsReq) (*GetContactRequestsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContactRequests not implemented")
 }
@@ -166,99 +555,480 @@ func (UnimplementedMessengerSvcServer) SendContactRequest(context.Context, *Send
 func (UnimplementedMessengerSvcServer) AcceptContactRequest(context.Context, *AcceptContactRequestReq) (*AcceptContactRequestRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptContactRequest not implemented")
 }
+func (UnimplementedMessengerSvcServer) ListOutgoingContactRequests(context.Context, *ListOutgoingContactRequestsReq) (*ListOutgoingContactRequestsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingContactRequests not implemented")
+}
+func (UnimplementedMessengerSvcServer) DiscardContactRequest(context.Context, *DiscardContactRequestReq) (*DiscardContactRequestRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardContactRequest not implemented")
+}
+func (UnimplementedMessengerSvcServer) BlockContact(context.Context, *BlockContactReq) (*BlockContactRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockContact not implemented")
+}
+func (UnimplementedMessengerSvcServer) UnblockContact(context.Context, *UnblockContactReq) (*UnblockContactRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockContact not implemented")
+}
+func (UnimplementedMessengerSvcServer) WatchContactRequests(*WatchContactRequestsReq, MessengerSvc_WatchContactRequestsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchContactRequests not implemented")
+}
+func (UnimplementedMessengerSvcServer) ListContactDecisions(context.Context, *ListContactDecisionsReq) (*ListContactDecisionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContactDecisions not implemented")
+}
+func (UnimplementedMessengerSvcServer) GetSafetyNumber(context.Context, *GetSafetyNumberReq) (*GetSafetyNumberRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSafetyNumber not implemented")
+}
+func (UnimplementedMessengerSvcServer) SetContactVerified(context.Context, *SetContactVerifiedReq) (*SetContactVerifiedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContactVerified not implemented")
+}
+func (UnimplementedMessengerSvcServer) SetContactEntry(context.Context, *SetContactEntryReq) (*SetContactEntryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContactEntry not implemented")
+}
+func (UnimplementedMessengerSvcServer) GetContactEntry(context.Context, *GetContactEntryReq) (*GetContactEntryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContactEntry not implemented")
+}
+func (UnimplementedMessengerSvcServer) ListContactEntries(context.Context, *ListContactEntriesReq) (*ListContactEntriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContactEntries not implemented")
+}
+func (UnimplementedMessengerSvcServer) DeleteContactEntry(context.Context, *DeleteContactEntryReq) (*DeleteContactEntryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContactEntry not implemented")
+}
+func (UnimplementedMessengerSvcServer) ListContacts(context.Context, *ListContactsReq) (*ListContactsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContacts not implemented")
+}
 func (UnimplementedMessengerSvcServer) SendMessage(context.Context, *SendMessageReq) (*SendMessageRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
 func (UnimplementedMessengerSvcServer) ListMessages(*ListMessagesReq, MessengerSvc_ListMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListMessages not implemented")
 }
+func (UnimplementedMessengerSvcServer) SubscribeMessages(*SubscribeMessagesReq, MessengerSvc_SubscribeMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMessages not implemented")
+}
 func (UnimplementedMessengerSvcServer) CreateGroup(context.Context, *CreateGroupReq) (*CreateGroupRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
 }
 func (UnimplementedMessengerSvcServer) JoinGroup(context.Context, *JoinGroupReq) (*JoinGroupRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinGroup not implemented")
 }
-func (UnimplementedMessengerSvcServer) mustEmbedUnimplementedMessengerSvcServer() {}
+func (UnimplementedMessengerSvcServer) ListGroups(context.Context, *ListGroupsReq) (*ListGroupsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedMessengerSvcServer) UpdateGroupProfile(context.Context, *UpdateGroupProfileReq) (*UpdateGroupProfileRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupProfile not implemented")
+}
+func (UnimplementedMessengerSvcServer) GetGroupProfile(context.Context, *GetGroupProfileReq) (*GetGroupProfileRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupProfile not implemented")
+}
+func (UnimplementedMessengerSvcServer) SetGroupSettings(context.Context, *SetGroupSettingsReq) (*SetGroupSettingsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupSettings not implemented")
+}
+func (UnimplementedMessengerSvcServer) CreateGroupInvitation(context.Context, *CreateGroupInvitationReq) (*CreateGroupInvitationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupInvitation not implemented")
+}
+func (UnimplementedMessengerSvcServer) GrantGroupAdmin(context.Context, *GrantGroupAdminReq) (*GrantGroupAdminRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantGroupAdmin not implemented")
+}
+func (UnimplementedMessengerSvcServer) ListGroupAdmins(context.Context, *ListGroupAdminsReq) (*ListGroupAdminsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupAdmins not implemented")
+}
+func (UnimplementedMessengerSvcServer) LeaveGroup(context.Context, *LeaveGroupReq) (*LeaveGroupRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
+func (UnimplementedMessengerSvcServer) ListGroupMembers(context.Context, *ListGroupMembersReq) (*ListGroupMembersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupMembers not implemented")
+}
+func (UnimplementedMessengerSvcServer) WatchGroupMembers(*WatchGroupMembersReq, MessengerSvc_WatchGroupMembersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGroupMembers not implemented")
+}
+func (UnimplementedMessengerSvcServer) mustEmbedUnimplementedMessengerSvcServer() {}
+
+// UnsafeMessengerSvcServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MessengerSvcServer will
+// result in compilation errors.
+type UnsafeMessengerSvcServer interface {
+	mustEmbedUnimplementedMessengerSvcServer()
+}
+
+func RegisterMessengerSvcServer(s grpc.ServiceRegistrar, srv MessengerSvcServer) {
+	s.RegisterService(&MessengerSvc_ServiceDesc, srv)
+}
+
+func _MessengerSvc_GetContactPubkey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactPubkeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).GetContactPubkey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/GetContactPubkey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).GetContactPubkey(ctx, req.(*GetContactPubkeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_ShareContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareContactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).ShareContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/ShareContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).ShareContact(ctx, req.(*ShareContactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_EnableContactRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableContactRequestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).EnableContactRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/EnableContactRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).EnableContactRequests(ctx, req.(*EnableContactRequestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_DisableContactRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableContactRequestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).DisableContactRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/DisableContactRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).DisableContactRequests(ctx, req.(*DisableContactRequestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_ResetContactReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetContactReferenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).ResetContactReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/ResetContactReference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).ResetContactReference(ctx, req.(*ResetContactReferenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_GetContactRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactRequestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).GetContactRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/GetContactRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).GetContactRequests(ctx, req.(*GetContactRequestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_SendContactRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendContactRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).SendContactRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/SendContactRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).SendContactRequest(ctx, req.(*SendContactRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_AcceptContactRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptContactRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).AcceptContactRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/AcceptContactRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).AcceptContactRequest(ctx, req.(*AcceptContactRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_ListOutgoingContactRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingContactRequestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).ListOutgoingContactRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/ListOutgoingContactRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).ListOutgoingContactRequests(ctx, req.(*ListOutgoingContactRequestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_DiscardContactRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardContactRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).DiscardContactRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/DiscardContactRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).DiscardContactRequest(ctx, req.(*DiscardContactRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_BlockContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockContactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).BlockContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/BlockContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).BlockContact(ctx, req.(*BlockContactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_UnblockContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockContactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).UnblockContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/UnblockContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).UnblockContact(ctx, req.(*UnblockContactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_WatchContactRequests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchContactRequestsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessengerSvcServer).WatchContactRequests(m, &messengerSvcWatchContactRequestsServer{stream})
+}
+
+type MessengerSvc_WatchContactRequestsServer interface {
+	Send(*ContactRequestEvent) error
+	grpc.ServerStream
+}
+
+type messengerSvcWatchContactRequestsServer struct {
+	grpc.ServerStream
+}
+
+func (x *messengerSvcWatchContactRequestsServer) Send(m *ContactRequestEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MessengerSvc_ListContactDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactDecisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).ListContactDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/ListContactDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).ListContactDecisions(ctx, req.(*ListContactDecisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_GetSafetyNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSafetyNumberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).GetSafetyNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/GetSafetyNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).GetSafetyNumber(ctx, req.(*GetSafetyNumberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UnsafeMessengerSvcServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MessengerSvcServer will
-// result in compilation errors.
-type UnsafeMessengerSvcServer interface {
-	mustEmbedUnimplementedMessengerSvcServer()
+func _MessengerSvc_SetContactVerified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContactVerifiedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).SetContactVerified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/SetContactVerified",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).SetContactVerified(ctx, req.(*SetContactVerifiedReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMessengerSvcServer(s grpc.ServiceRegistrar, srv MessengerSvcServer) {
-	s.RegisterService(&MessengerSvc_ServiceDesc, srv)
+func _MessengerSvc_SetContactEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContactEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).SetContactEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/SetContactEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).SetContactEntry(ctx, req.(*SetContactEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MessengerSvc_GetContactPubkey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContactPubkeyReq)
+func _MessengerSvc_GetContactEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactEntryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MessengerSvcServer).GetContactPubkey(ctx, in)
+		return srv.(MessengerSvcServer).GetContactEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/MessengerSvc/GetContactPubkey",
+		FullMethod: "/MessengerSvc/GetContactEntry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MessengerSvcServer).GetContactPubkey(ctx, req.(*GetContactPubkeyReq))
+		return srv.(MessengerSvcServer).GetContactEntry(ctx, req.(*GetContactEntryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MessengerSvc_GetContactRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContactRequestsReq)
+func _MessengerSvc_ListContactEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactEntriesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MessengerSvcServer).GetContactRequests(ctx, in)
+		return srv.(MessengerSvcServer).ListContactEntries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/MessengerSvc/GetContactRequests",
+		FullMethod: "/MessengerSvc/ListContactEntries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MessengerSvcServer).GetContactRequests(ctx, req.(*GetContactRequestsReq))
+		return srv.(MessengerSvcServer).ListContactEntries(ctx, req.(*ListContactEntriesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MessengerSvc_SendContactRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendContactRequestReq)
+func _MessengerSvc_DeleteContactEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactEntryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MessengerSvcServer).SendContactRequest(ctx, in)
+		return srv.(MessengerSvcServer).DeleteContactEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/MessengerSvc/SendContactRequest",
+		FullMethod: "/MessengerSvc/DeleteContactEntry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MessengerSvcServer).SendContactRequest(ctx, req.(*SendContactRequestReq))
+		return srv.(MessengerSvcServer).DeleteContactEntry(ctx, req.(*DeleteContactEntryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MessengerSvc_AcceptContactRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcceptContactRequestReq)
+func _MessengerSvc_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MessengerSvcServer).AcceptContactRequest(ctx, in)
+		return srv.(MessengerSvcServer).ListContacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/MessengerSvc/AcceptContactRequest",
+		FullMethod: "/MessengerSvc/ListContacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MessengerSvcServer).AcceptContactRequest(ctx, req.(*AcceptContactRequestReq))
+		return srv.(MessengerSvcServer).ListContacts(ctx, req.(*ListContactsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -302,6 +1072,27 @@ func (x *messengerSvcListMessagesServer) Send(m *ListMessagesRes) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MessengerSvc_SubscribeMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMessagesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessengerSvcServer).SubscribeMessages(m, &messengerSvcSubscribeMessagesServer{stream})
+}
+
+type MessengerSvc_SubscribeMessagesServer interface {
+	Send(*ListMessagesRes) error
+	grpc.ServerStream
+}
+
+type messengerSvcSubscribeMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *messengerSvcSubscribeMessagesServer) Send(m *ListMessagesRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MessengerSvc_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateGroupReq)
 	if err := dec(in); err != nil {
@@ -338,6 +1129,189 @@ func _MessengerSvc_JoinGroup_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessengerSvc_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).ListGroups(ctx, req.(*ListGroupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_UpdateGroupProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).UpdateGroupProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/UpdateGroupProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).UpdateGroupProfile(ctx, req.(*UpdateGroupProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_GetGroupProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).GetGroupProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/GetGroupProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).GetGroupProfile(ctx, req.(*GetGroupProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_SetGroupSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).SetGroupSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/SetGroupSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).SetGroupSettings(ctx, req.(*SetGroupSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_CreateGroupInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupInvitationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).CreateGroupInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/CreateGroupInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).CreateGroupInvitation(ctx, req.(*CreateGroupInvitationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_GrantGroupAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantGroupAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).GrantGroupAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/GrantGroupAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).GrantGroupAdmin(ctx, req.(*GrantGroupAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_ListGroupAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupAdminsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).ListGroupAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/ListGroupAdmins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).ListGroupAdmins(ctx, req.(*ListGroupAdminsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/LeaveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).LeaveGroup(ctx, req.(*LeaveGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_ListGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessengerSvcServer).ListGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MessengerSvc/ListGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessengerSvcServer).ListGroupMembers(ctx, req.(*ListGroupMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessengerSvc_WatchGroupMembers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGroupMembersReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessengerSvcServer).WatchGroupMembers(m, &messengerSvcWatchGroupMembersServer{stream})
+}
+
+type MessengerSvc_WatchGroupMembersServer interface {
+	Send(*GroupMemberEvent) error
+	grpc.ServerStream
+}
+
+type messengerSvcWatchGroupMembersServer struct {
+	grpc.ServerStream
+}
+
+func (x *messengerSvcWatchGroupMembersServer) Send(m *GroupMemberEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // MessengerSvc_ServiceDesc is the grpc.ServiceDesc for MessengerSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -349,6 +1323,22 @@ var MessengerSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetContactPubkey",
 			Handler:    _MessengerSvc_GetContactPubkey_Handler,
 		},
+		{
+			MethodName: "ShareContact",
+			Handler:    _MessengerSvc_ShareContact_Handler,
+		},
+		{
+			MethodName: "EnableContactRequests",
+			Handler:    _MessengerSvc_EnableContactRequests_Handler,
+		},
+		{
+			MethodName: "DisableContactRequests",
+			Handler:    _MessengerSvc_DisableContactRequests_Handler,
+		},
+		{
+			MethodName: "ResetContactReference",
+			Handler:    _MessengerSvc_ResetContactReference_Handler,
+		},
 		{
 			MethodName: "GetContactRequests",
 			Handler:    _MessengerSvc_GetContactRequests_Handler,
@@ -361,6 +1351,54 @@ var MessengerSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptContactRequest",
 			Handler:    _MessengerSvc_AcceptContactRequest_Handler,
 		},
+		{
+			MethodName: "ListOutgoingContactRequests",
+			Handler:    _MessengerSvc_ListOutgoingContactRequests_Handler,
+		},
+		{
+			MethodName: "DiscardContactRequest",
+			Handler:    _MessengerSvc_DiscardContactRequest_Handler,
+		},
+		{
+			MethodName: "BlockContact",
+			Handler:    _MessengerSvc_BlockContact_Handler,
+		},
+		{
+			MethodName: "UnblockContact",
+			Handler:    _MessengerSvc_UnblockContact_Handler,
+		},
+		{
+			MethodName: "ListContactDecisions",
+			Handler:    _MessengerSvc_ListContactDecisions_Handler,
+		},
+		{
+			MethodName: "GetSafetyNumber",
+			Handler:    _MessengerSvc_GetSafetyNumber_Handler,
+		},
+		{
+			MethodName: "SetContactVerified",
+			Handler:    _MessengerSvc_SetContactVerified_Handler,
+		},
+		{
+			MethodName: "SetContactEntry",
+			Handler:    _MessengerSvc_SetContactEntry_Handler,
+		},
+		{
+			MethodName: "GetContactEntry",
+			Handler:    _MessengerSvc_GetContactEntry_Handler,
+		},
+		{
+			MethodName: "ListContactEntries",
+			Handler:    _MessengerSvc_ListContactEntries_Handler,
+		},
+		{
+			MethodName: "DeleteContactEntry",
+			Handler:    _MessengerSvc_DeleteContactEntry_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _MessengerSvc_ListContacts_Handler,
+		},
 		{
 			MethodName: "SendMessage",
 			Handler:    _MessengerSvc_SendMessage_Handler,
@@ -373,13 +1411,64 @@ var MessengerSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "JoinGroup",
 			Handler:    _MessengerSvc_JoinGroup_Handler,
 		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _MessengerSvc_ListGroups_Handler,
+		},
+		{
+			MethodName: "UpdateGroupProfile",
+			Handler:    _MessengerSvc_UpdateGroupProfile_Handler,
+		},
+		{
+			MethodName: "GetGroupProfile",
+			Handler:    _MessengerSvc_GetGroupProfile_Handler,
+		},
+		{
+			MethodName: "SetGroupSettings",
+			Handler:    _MessengerSvc_SetGroupSettings_Handler,
+		},
+		{
+			MethodName: "CreateGroupInvitation",
+			Handler:    _MessengerSvc_CreateGroupInvitation_Handler,
+		},
+		{
+			MethodName: "GrantGroupAdmin",
+			Handler:    _MessengerSvc_GrantGroupAdmin_Handler,
+		},
+		{
+			MethodName: "ListGroupAdmins",
+			Handler:    _MessengerSvc_ListGroupAdmins_Handler,
+		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _MessengerSvc_LeaveGroup_Handler,
+		},
+		{
+			MethodName: "ListGroupMembers",
+			Handler:    _MessengerSvc_ListGroupMembers_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchContactRequests",
+			Handler:       _MessengerSvc_WatchContactRequests_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListMessages",
 			Handler:       _MessengerSvc_ListMessages_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeMessages",
+			Handler:       _MessengerSvc_SubscribeMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchGroupMembers",
+			Handler:       _MessengerSvc_WatchGroupMembers_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "messenger.proto",
 }
@@ -0,0 +1,1943 @@
+package messenger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	messenger "github.com/adapterkit/adapterkit-module-berty-messenger"
+	"github.com/adapterkit/adapterkit-module-berty-messenger/messengertest"
+	datastore "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestService(t *testing.T, node *messengertest.Node, opts ...messenger.Option) *messenger.Service {
+	t.Helper()
+
+	opts = append([]messenger.Option{
+		messenger.WithNodeAddr(messengertest.Addr),
+		messenger.WithDialOptions(node.DialOption()),
+	}, opts...)
+	s, err := messenger.NewWithOptions(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	t.Cleanup(func() { _ = s.Close() })
+
+	return s
+}
+
+// newTestClient serves a Service in front of node and returns a client to it.
+func newTestClient(t *testing.T, node *messengertest.Node) messenger.MessengerSvcClient {
+	t.Helper()
+
+	return serve(t, newTestService(t, node))
+}
+
+func serve(t *testing.T, s *messenger.Service) messenger.MessengerSvcClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	messenger.RegisterMessengerSvcServer(server, s)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial service: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return messenger.NewMessengerSvcClient(conn)
+}
+
+func newTestNodes(t *testing.T, count int) []*messengertest.Node {
+	t.Helper()
+
+	network := messengertest.NewNetwork()
+	nodes := make([]*messengertest.Node, count)
+	for i := range nodes {
+		nodes[i] = network.NewNode()
+		t.Cleanup(nodes[i].Close)
+	}
+	return nodes
+}
+
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// addContact makes a and b contacts, a being the requester.
+func addContact(ctx context.Context, t *testing.T, a, b messenger.MessengerSvcClient) (aPK, bPK string) {
+	t.Helper()
+
+	aRef, err := a.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get a pubkey: %v", err)
+	}
+	bRef, err := b.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get b pubkey: %v", err)
+	}
+
+	if _, err := a.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bRef.Pubkey, RdvSeed: bRef.RdvSeed, Name: "a"}); err != nil {
+		t.Fatalf("send contact request: %v", err)
+	}
+	if _, err := b.AcceptContactRequest(ctx, &messenger.AcceptContactRequestReq{Pubkey: aRef.Pubkey}); err != nil {
+		t.Fatalf("accept contact request: %v", err)
+	}
+
+	return aRef.Pubkey, bRef.Pubkey
+}
+
+func listMessages(ctx context.Context, t *testing.T, c messenger.MessengerSvcClient, req *messenger.ListMessagesReq) []*messenger.ListMessagesRes {
+	t.Helper()
+
+	msgs, err := collectMessages(ctx, c, req)
+	if err != nil {
+		t.Fatalf("list messages: %v", err)
+	}
+	return msgs
+}
+
+func collectMessages(ctx context.Context, c messenger.MessengerSvcClient, req *messenger.ListMessagesReq) ([]*messenger.ListMessagesRes, error) {
+	stream, err := c.ListMessages(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []*messenger.ListMessagesRes
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return msgs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+}
+
+func TestNewWithOptionsRequiresNode(t *testing.T) {
+	if _, err := messenger.NewWithOptions(context.Background()); err == nil {
+		t.Fatal("expected an error without node address nor client")
+	}
+}
+
+func TestNewWithOptionsDialTimeout(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	_ = lis.Close()
+
+	_, err := messenger.NewWithOptions(context.Background(),
+		messenger.WithNodeAddr(messengertest.Addr),
+		messenger.WithDialTimeout(100*time.Millisecond),
+		messenger.WithDialOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) })),
+	)
+	if err == nil {
+		t.Fatal("expected a dial error for an unreachable node")
+	}
+}
+
+func TestServiceClose(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	ctx := testContext(t)
+
+	s, err := messenger.NewWithOptions(ctx,
+		messenger.WithNodeAddr(messengertest.Addr),
+		messenger.WithDialOptions(nodes[0].DialOption()),
+		messenger.WithDialTimeout(time.Second),
+	)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	if state := s.State(); state != connectivity.Ready {
+		t.Fatalf("expected a ready connection, got %s", state)
+	}
+
+	if err := s.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if state := s.State(); state != connectivity.Shutdown {
+		t.Fatalf("expected a shut down connection, got %s", state)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("close again: %v", err)
+	}
+}
+
+func TestProtocolClientOption(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	ctx := testContext(t)
+
+	conn, err := nodes[0].Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial node: %v", err)
+	}
+	defer conn.Close()
+
+	s, err := messenger.NewWithOptions(ctx, messenger.WithProtocolClient(protocoltypes.NewProtocolServiceClient(conn)))
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+
+	res, err := s.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	if res.Pubkey != base64.StdEncoding.EncodeToString(nodes[0].AccountPK) {
+		t.Fatalf("unexpected pubkey %q", res.Pubkey)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+}
+
+func TestGetContactPubkey(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	ctx := testContext(t)
+	c := newTestClient(t, nodes[0])
+
+	res, err := c.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	if res.Pubkey != base64.StdEncoding.EncodeToString(nodes[0].AccountPK) {
+		t.Fatalf("unexpected pubkey %q", res.Pubkey)
+	}
+	if res.RdvSeed == "" {
+		t.Fatal("expected a rendezvous seed")
+	}
+	if !res.Enabled {
+		t.Fatal("expected contact requests to be enabled")
+	}
+}
+
+func TestContactRequestReference(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+
+	if _, err := bob.DisableContactRequests(ctx, &messenger.DisableContactRequestsReq{}); err != nil {
+		t.Fatalf("disable: %v", err)
+	}
+	ref, err := bob.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	if ref.Enabled {
+		t.Fatal("expected contact requests to be disabled")
+	}
+
+	reset, err := bob.ResetContactReference(ctx, &messenger.ResetContactReferenceReq{})
+	if err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+	if reset.RdvSeed == ref.RdvSeed {
+		t.Fatal("expected a new rendezvous seed")
+	}
+
+	enabled, err := bob.EnableContactRequests(ctx, &messenger.EnableContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("enable: %v", err)
+	}
+	if enabled.RdvSeed != reset.RdvSeed {
+		t.Fatalf("expected seed %q, got %q", reset.RdvSeed, enabled.RdvSeed)
+	}
+
+	// a request to the old seed never reaches bob.
+	if _, err := alice.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: ref.Pubkey, RdvSeed: ref.RdvSeed}); err != nil {
+		t.Fatalf("send contact request: %v", err)
+	}
+	requests, err := bob.GetContactRequests(ctx, &messenger.GetContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("get contact requests: %v", err)
+	}
+	if len(requests.ContactRequests) != 0 {
+		t.Fatalf("expected no request, got %v", requests.ContactRequests)
+	}
+}
+
+func TestShareContactLink(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	bobPK := base64.StdEncoding.EncodeToString(nodes[1].AccountPK)
+
+	shared, err := alice.ShareContact(ctx, &messenger.ShareContactReq{DisplayName: "alice", QrCode: true})
+	if err != nil {
+		t.Fatalf("share contact: %v", err)
+	}
+	if shared.Link == "" || shared.WebLink == "" {
+		t.Fatalf("expected links, got %v", shared)
+	}
+	if !bytes.HasPrefix(shared.QrCodePng, []byte("\x89PNG")) {
+		t.Fatal("expected a PNG QR code")
+	}
+
+	if _, err := bob.SendContactRequest(ctx, &messenger.SendContactRequestReq{Link: "https://example.com"}); err == nil {
+		t.Fatal("expected an error for an invalid link")
+	}
+	for _, link := range []string{shared.Link, shared.WebLink} {
+		if _, err := bob.SendContactRequest(ctx, &messenger.SendContactRequestReq{Link: link, Name: "bob"}); err != nil {
+			t.Fatalf("send contact request to %q: %v", link, err)
+		}
+	}
+
+	requests, err := alice.GetContactRequests(ctx, &messenger.GetContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("get contact requests: %v", err)
+	}
+	if len(requests.ContactRequests) != 1 || requests.ContactRequests[0].PublicKey != bobPK {
+		t.Fatalf("unexpected contact requests %v", requests.ContactRequests)
+	}
+}
+
+func TestReferenceRotation(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	ctx := testContext(t)
+	s := newTestService(t, nodes[0], messenger.WithReferenceRotation(20*time.Millisecond))
+
+	first, err := s.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	eventually(ctx, t, func() error {
+		ref, err := s.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+		if err != nil {
+			return err
+		}
+		if ref.RdvSeed == first.RdvSeed {
+			return errors.New("seed not rotated yet")
+		}
+		return nil
+	})
+
+	if err := s.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+}
+
+func TestContactRequests(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+
+	bobRef, err := bob.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	if _, err := alice.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed, Name: "alice"}); err != nil {
+		t.Fatalf("send contact request: %v", err)
+	}
+
+	requests, err := bob.GetContactRequests(ctx, &messenger.GetContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("get contact requests: %v", err)
+	}
+	if len(requests.ContactRequests) != 1 {
+		t.Fatalf("expected 1 contact request, got %d", len(requests.ContactRequests))
+	}
+	request := requests.ContactRequests[0]
+	if request.Name != "alice" || request.PublicKey != base64.StdEncoding.EncodeToString(nodes[0].AccountPK) {
+		t.Fatalf("unexpected contact request %v", request)
+	}
+
+	if _, err := bob.AcceptContactRequest(ctx, &messenger.AcceptContactRequestReq{Pubkey: request.PublicKey}); err != nil {
+		t.Fatalf("accept contact request: %v", err)
+	}
+
+	requests, err = bob.GetContactRequests(ctx, &messenger.GetContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("get contact requests: %v", err)
+	}
+	if len(requests.ContactRequests) != 0 {
+		t.Fatalf("expected no pending contact request, got %v", requests.ContactRequests)
+	}
+}
+
+func TestSendContactRequestDefaultName(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+
+	bobRef, err := bob.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	if _, err := alice.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed}); err != nil {
+		t.Fatalf("send contact request: %v", err)
+	}
+
+	requests, err := bob.GetContactRequests(ctx, &messenger.GetContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("get contact requests: %v", err)
+	}
+	if len(requests.ContactRequests) != 1 || requests.ContactRequests[0].Name != "Anonymous" {
+		t.Fatalf("unexpected contact requests %v", requests.ContactRequests)
+	}
+}
+
+func TestSendContactRequestInvalidKey(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	ctx := testContext(t)
+	c := newTestClient(t, nodes[0])
+
+	if _, err := c.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: "not base64!", RdvSeed: ""}); err == nil {
+		t.Fatal("expected a decode error")
+	}
+}
+
+func TestContactMessages(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK, bobPK := addContact(ctx, t, alice, bob)
+
+	for _, msg := range []string{"hello", "bob"} {
+		if _, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: bobPK, Message: msg, IsContact: true}); err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+	}
+
+	msgs := listMessages(ctx, t, bob, &messenger.ListMessagesReq{Pubkey: alicePK, IsContact: true})
+	if len(msgs) != 2 || msgs[0].Message != "bob" || msgs[1].Message != "hello" {
+		t.Fatalf("unexpected messages %v", msgs)
+	}
+}
+
+func TestMessagesUnknownContact(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	c := newTestClient(t, nodes[0])
+	pk := base64.StdEncoding.EncodeToString(nodes[1].AccountPK)
+
+	if _, err := c.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: pk, Message: "hello", IsContact: true}); err == nil {
+		t.Fatal("expected an error sending to a stranger")
+	}
+
+	stream, err := c.ListMessages(ctx, &messenger.ListMessagesReq{Pubkey: pk, IsContact: true})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if err == nil {
+		t.Fatal("expected an error listing messages of a stranger")
+	}
+}
+
+func TestGroupMessages(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	ctx := testContext(t)
+	alice, bob, carol := newTestClient(t, nodes[0]), newTestClient(t, nodes[1]), newTestClient(t, nodes[2])
+
+	group, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	for _, c := range []messenger.MessengerSvcClient{bob, carol} {
+		if _, err := c.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: group.GroupInvitation}); err != nil {
+			t.Fatalf("join group: %v", err)
+		}
+	}
+
+	for i, c := range []messenger.MessengerSvcClient{alice, bob, carol} {
+		if _, err := c.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: group.GroupPk, Message: string(rune('a' + i))}); err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+	}
+
+	msgs := listMessages(ctx, t, carol, &messenger.ListMessagesReq{Pubkey: group.GroupPk})
+	if len(msgs) != 3 || msgs[0].Message != "c" || msgs[1].Message != "b" || msgs[2].Message != "a" {
+		t.Fatalf("unexpected messages %v", msgs)
+	}
+}
+
+func TestJoinGroupInvalidInvitation(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	ctx := testContext(t)
+	c := newTestClient(t, nodes[0])
+
+	if _, err := c.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: "not base64!"}); err == nil {
+		t.Fatal("expected a decode error")
+	}
+}
+
+func TestMessageIDs(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+
+	group, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	if _, err := bob.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: group.GroupInvitation}); err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+
+	var ids []string
+	for _, c := range []messenger.MessengerSvcClient{alice, bob} {
+		res, err := c.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: group.GroupPk, Message: "hello"})
+		if err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+		if res.Id == "" {
+			t.Fatal("expected a message id")
+		}
+		ids = append(ids, res.Id)
+	}
+
+	msgs := listMessages(ctx, t, bob, &messenger.ListMessagesReq{Pubkey: group.GroupPk})
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(msgs))
+	}
+	fromBob, fromAlice := msgs[0], msgs[1]
+	if fromAlice.Id != ids[0] || fromBob.Id != ids[1] {
+		t.Fatalf("unexpected message ids %q, %q", fromAlice.Id, fromBob.Id)
+	}
+	if len(fromBob.ParentIds) != 1 || fromBob.ParentIds[0] != fromAlice.Id {
+		t.Fatalf("unexpected parent ids %v", fromBob.ParentIds)
+	}
+	if fromAlice.DevicePk != base64.StdEncoding.EncodeToString(nodes[0].DevicePK) || fromBob.DevicePk != base64.StdEncoding.EncodeToString(nodes[1].DevicePK) {
+		t.Fatalf("unexpected device pks %q, %q", fromAlice.DevicePk, fromBob.DevicePk)
+	}
+	if fromAlice.MemberPk == "" || fromBob.MemberPk == "" || fromAlice.MemberPk == fromBob.MemberPk {
+		t.Fatalf("unexpected member pks %q, %q", fromAlice.MemberPk, fromBob.MemberPk)
+	}
+}
+
+// unannouncedDevices hides the devices sending messages, and counts the
+// metadata events listed.
+type unannouncedDevices struct {
+	protocoltypes.ProtocolServiceClient
+	listed *int32
+}
+
+func (c unannouncedDevices) GroupMessageList(ctx context.Context, in *protocoltypes.GroupMessageList_Request, opts ...grpc.CallOption) (protocoltypes.ProtocolService_GroupMessageListClient, error) {
+	list, err := c.ProtocolServiceClient.GroupMessageList(ctx, in, opts...)
+	return hiddenDeviceMessages{list}, err
+}
+
+func (c unannouncedDevices) GroupMetadataList(ctx context.Context, in *protocoltypes.GroupMetadataList_Request, opts ...grpc.CallOption) (protocoltypes.ProtocolService_GroupMetadataListClient, error) {
+	list, err := c.ProtocolServiceClient.GroupMetadataList(ctx, in, opts...)
+	return countedMetadata{list, c.listed}, err
+}
+
+type hiddenDeviceMessages struct {
+	protocoltypes.ProtocolService_GroupMessageListClient
+}
+
+func (l hiddenDeviceMessages) Recv() (*protocoltypes.GroupMessageEvent, error) {
+	msg, err := l.ProtocolService_GroupMessageListClient.Recv()
+	if err == nil {
+		msg.Headers.DevicePK = []byte("unannounced")
+	}
+	return msg, err
+}
+
+type countedMetadata struct {
+	protocoltypes.ProtocolService_GroupMetadataListClient
+	listed *int32
+}
+
+func (l countedMetadata) Recv() (*protocoltypes.GroupMetadataEvent, error) {
+	meta, err := l.ProtocolService_GroupMetadataListClient.Recv()
+	if err == nil {
+		atomic.AddInt32(l.listed, 1)
+	}
+	return meta, err
+}
+
+func TestUnannouncedDevices(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	ctx := testContext(t)
+
+	conn, err := nodes[0].Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial node: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	var listed int32
+	alice := serve(t, newTestService(t, nodes[0], messenger.WithProtocolClient(unannouncedDevices{protocoltypes.NewProtocolServiceClient(conn), &listed})))
+
+	group, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		if _, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: group.GroupPk, Message: fmt.Sprint(i)}); err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+	}
+
+	listMessages(ctx, t, alice, &messenger.ListMessagesReq{Pubkey: group.GroupPk, Limit: 1})
+	replayed := atomic.SwapInt32(&listed, 0)
+
+	// the metadata already replayed is not listed again for each message
+	msgs := listMessages(ctx, t, alice, &messenger.ListMessagesReq{Pubkey: group.GroupPk})
+	if len(msgs) != 5 || msgs[0].MemberPk != "" {
+		t.Fatalf("unexpected messages %v", msgs)
+	}
+	if got := atomic.LoadInt32(&listed); got > int32(len(msgs)) {
+		t.Fatalf("expected at most %d metadata events listed, got %d for %d at first", len(msgs), got, replayed)
+	}
+}
+
+func TestBinaryPayloads(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK, bobPK := addContact(ctx, t, alice, bob)
+
+	sent := []*messenger.SendMessageReq{
+		{Pubkey: bobPK, IsContact: true, Message: "text"},
+		{Pubkey: bobPK, IsContact: true, Payload: []byte{0xff, 0x00, 'a'}},
+		{Pubkey: bobPK, IsContact: true, Payload: []byte{0xfe, 'b'}, ContentType: "application/octet-stream"},
+	}
+	for _, req := range sent {
+		if _, err := alice.SendMessage(ctx, req); err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+	}
+
+	msgs := listMessages(ctx, t, bob, &messenger.ListMessagesReq{Pubkey: alicePK, IsContact: true})
+	if len(msgs) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(msgs))
+	}
+	typed, raw, text := msgs[0], msgs[1], msgs[2]
+
+	if text.Message != "text" || string(text.Payload) != "text" || !text.ValidUtf8 || text.ContentType != "" {
+		t.Fatalf("unexpected text message %v", text)
+	}
+	if !bytes.Equal(raw.Payload, []byte{0xff, 0x00, 'a'}) || raw.ValidUtf8 || raw.Message != "�\x00a" {
+		t.Fatalf("unexpected raw message %v", raw)
+	}
+	if !bytes.Equal(typed.Payload, []byte{0xfe, 'b'}) || typed.ContentType != "application/octet-stream" || typed.ValidUtf8 {
+		t.Fatalf("unexpected typed message %v", typed)
+	}
+}
+
+func TestMessengerFormat(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK, bobPK := addContact(ctx, t, alice, bob)
+	messengerFormat := messenger.MessageFormat_MESSAGE_FORMAT_MESSENGER
+
+	first, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: bobPK, IsContact: true, Message: "hi", Format: messengerFormat})
+	if err != nil {
+		t.Fatalf("send message: %v", err)
+	}
+	sent := []*messenger.SendMessageReq{
+		{Pubkey: bobPK, IsContact: true, Message: "reply", TargetId: first.Id, Format: messengerFormat},
+		{Pubkey: bobPK, IsContact: true, AppMessageType: messenger.AppMessageType_APP_MESSAGE_TYPE_ACKNOWLEDGE, TargetId: first.Id, Format: messengerFormat},
+		{Pubkey: bobPK, IsContact: true, AppMessageType: messenger.AppMessageType_APP_MESSAGE_TYPE_SET_USER_INFO, Message: "Alice", Format: messengerFormat},
+		{Pubkey: bobPK, IsContact: true, Message: "raw"},
+	}
+	for _, req := range sent {
+		if _, err := alice.SendMessage(ctx, req); err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+	}
+
+	if _, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: bobPK, IsContact: true, AppMessageType: messenger.AppMessageType_APP_MESSAGE_TYPE_ACKNOWLEDGE, Format: messengerFormat}); err == nil {
+		t.Fatal("expected an error acknowledging without target")
+	}
+
+	msgs := listMessages(ctx, t, bob, &messenger.ListMessagesReq{Pubkey: alicePK, IsContact: true, Format: messengerFormat})
+	if len(msgs) != 5 {
+		t.Fatalf("expected 5 messages, got %d", len(msgs))
+	}
+	raw, info, ack, reply, hi := msgs[0], msgs[1], msgs[2], msgs[3], msgs[4]
+
+	if hi.AppMessageType != messenger.AppMessageType_APP_MESSAGE_TYPE_USER_MESSAGE || hi.Message != "hi" || hi.SentDate == 0 {
+		t.Fatalf("unexpected user message %v", hi)
+	}
+	if reply.Message != "reply" || reply.TargetId != first.Id {
+		t.Fatalf("unexpected reply %v", reply)
+	}
+	if ack.AppMessageType != messenger.AppMessageType_APP_MESSAGE_TYPE_ACKNOWLEDGE || ack.TargetId != first.Id {
+		t.Fatalf("unexpected acknowledge %v", ack)
+	}
+	if info.AppMessageType != messenger.AppMessageType_APP_MESSAGE_TYPE_SET_USER_INFO || info.Message != "Alice" {
+		t.Fatalf("unexpected user info %v", info)
+	}
+	if raw.AppMessageType != messenger.AppMessageType_APP_MESSAGE_TYPE_UNDEFINED || raw.Message != "raw" {
+		t.Fatalf("unexpected raw message %v", raw)
+	}
+
+	msgs = listMessages(ctx, t, bob, &messenger.ListMessagesReq{Pubkey: alicePK, IsContact: true})
+	if msgs[4].AppMessageType != messenger.AppMessageType_APP_MESSAGE_TYPE_UNDEFINED || msgs[4].Message == "hi" {
+		t.Fatalf("expected the raw envelope, got %v", msgs[4])
+	}
+}
+
+func TestSubscribeMessages(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK, bobPK := addContact(ctx, t, alice, bob)
+
+	send := func(msg string) string {
+		t.Helper()
+		res, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: bobPK, IsContact: true, Message: msg})
+		if err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+		return res.Id
+	}
+	subscribe := func(req *messenger.SubscribeMessagesReq) messenger.MessengerSvc_SubscribeMessagesClient {
+		t.Helper()
+		subCtx, cancel := context.WithCancel(ctx)
+		t.Cleanup(cancel)
+		req.Pubkey, req.IsContact = alicePK, true
+		sub, err := bob.SubscribeMessages(subCtx, req)
+		if err != nil {
+			t.Fatalf("subscribe: %v", err)
+		}
+		return sub
+	}
+	expect := func(sub messenger.MessengerSvc_SubscribeMessagesClient, want ...string) {
+		t.Helper()
+		for _, msg := range want {
+			res, err := sub.Recv()
+			if err != nil {
+				t.Fatalf("recv: %v", err)
+			}
+			if res.Message != msg {
+				t.Fatalf("expected %q, got %q", msg, res.Message)
+			}
+		}
+	}
+
+	firstID := send("first")
+	send("second")
+
+	live := subscribe(&messenger.SubscribeMessagesReq{})
+	replay := subscribe(&messenger.SubscribeMessagesReq{ReplayHistory: true})
+	since := subscribe(&messenger.SubscribeMessagesReq{SinceId: firstID})
+	expect(replay, "first", "second")
+	expect(since, "second")
+
+	if err := nodes[1].WaitLiveStreams(ctx, 3); err != nil {
+		t.Fatalf("wait subscriptions: %v", err)
+	}
+
+	send("third")
+	expect(live, "third")
+	expect(replay, "third")
+	expect(since, "third")
+}
+
+func TestListMessagesPagination(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK, bobPK := addContact(ctx, t, alice, bob)
+
+	var ids []string
+	for i := 0; i < 6; i++ {
+		from, to := alice, bobPK
+		if i%2 == 1 {
+			from, to = bob, alicePK
+		}
+		res, err := from.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: to, IsContact: true, Message: fmt.Sprint(i)})
+		if err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+		ids = append(ids, res.Id)
+	}
+
+	oldestFirst := messenger.ListOrder_LIST_ORDER_OLDEST_FIRST
+	for _, tc := range []struct {
+		name string
+		req  *messenger.ListMessagesReq
+		want string
+	}{
+		{"all", &messenger.ListMessagesReq{}, "543210"},
+		{"oldest first", &messenger.ListMessagesReq{Order: oldestFirst}, "012345"},
+		{"limit", &messenger.ListMessagesReq{Order: oldestFirst, Limit: 2}, "01"},
+		{"since", &messenger.ListMessagesReq{Order: oldestFirst, SinceId: ids[1]}, "2345"},
+		{"until", &messenger.ListMessagesReq{UntilId: ids[4]}, "3210"},
+		{"until oldest first", &messenger.ListMessagesReq{Order: oldestFirst, UntilId: ids[4]}, "0123"},
+		{"range", &messenger.ListMessagesReq{Order: oldestFirst, SinceId: ids[0], UntilId: ids[4]}, "123"},
+		{"range limit", &messenger.ListMessagesReq{Order: oldestFirst, SinceId: ids[0], UntilId: ids[4], Limit: 2}, "12"},
+		{"range newest first", &messenger.ListMessagesReq{SinceId: ids[0], UntilId: ids[4]}, "321"},
+		{"exclude own", &messenger.ListMessagesReq{ExcludeOwn: true}, "420"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.Pubkey, tc.req.IsContact = alicePK, true
+			got := ""
+			for _, msg := range listMessages(ctx, t, bob, tc.req) {
+				got += msg.Message
+			}
+			if got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+// countedMessages counts the message events listed.
+type countedMessages struct {
+	protocoltypes.ProtocolServiceClient
+	listed *int32
+}
+
+func (c countedMessages) GroupMessageList(ctx context.Context, in *protocoltypes.GroupMessageList_Request, opts ...grpc.CallOption) (protocoltypes.ProtocolService_GroupMessageListClient, error) {
+	list, err := c.ProtocolServiceClient.GroupMessageList(ctx, in, opts...)
+	return countedMessageList{list, c.listed}, err
+}
+
+type countedMessageList struct {
+	protocoltypes.ProtocolService_GroupMessageListClient
+	listed *int32
+}
+
+func (l countedMessageList) Recv() (*protocoltypes.GroupMessageEvent, error) {
+	msg, err := l.ProtocolService_GroupMessageListClient.Recv()
+	if err == nil {
+		atomic.AddInt32(l.listed, 1)
+	}
+	return msg, err
+}
+
+func TestListMessagesUntilRange(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	ctx := testContext(t)
+
+	conn, err := nodes[0].Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial node: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	var listed int32
+	alice := serve(t, newTestService(t, nodes[0], messenger.WithProtocolClient(countedMessages{protocoltypes.NewProtocolServiceClient(conn), &listed})))
+
+	group, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	var ids []string
+	for i := 0; i < 6; i++ {
+		res, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: group.GroupPk, Message: fmt.Sprint(i)})
+		if err != nil {
+			t.Fatalf("send message: %v", err)
+		}
+		ids = append(ids, res.Id)
+	}
+
+	// only the messages up to until_id are streamed by the node
+	msgs := listMessages(ctx, t, alice, &messenger.ListMessagesReq{Pubkey: group.GroupPk, UntilId: ids[1]})
+	if len(msgs) != 1 || msgs[0].Message != "0" {
+		t.Fatalf("unexpected messages %v", msgs)
+	}
+	if got := atomic.LoadInt32(&listed); got != 2 {
+		t.Fatalf("expected 2 message events listed, got %d", got)
+	}
+}
+
+func TestListContacts(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK, bobPK := addContact(ctx, t, alice, bob)
+
+	strangerPK := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	if _, err := alice.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: strangerPK, RdvSeed: strangerPK}); err != nil {
+		t.Fatalf("send contact request: %v", err)
+	}
+
+	aliceContacts, err := alice.ListContacts(ctx, &messenger.ListContactsReq{ResolveAliasKeys: true})
+	if err != nil {
+		t.Fatalf("list contacts: %v", err)
+	}
+	if len(aliceContacts.Contacts) != 2 {
+		t.Fatalf("expected 2 contacts, got %v", aliceContacts.Contacts)
+	}
+	sent, enqueued := aliceContacts.Contacts[0], aliceContacts.Contacts[1]
+	if sent.PublicKey != bobPK || sent.State != messenger.ContactState_CONTACT_STATE_ACCEPTED || sent.Direction != messenger.ContactDirection_CONTACT_DIRECTION_OUTGOING {
+		t.Fatalf("unexpected accepted outgoing contact %v", sent)
+	}
+	if enqueued.PublicKey != strangerPK || enqueued.State != messenger.ContactState_CONTACT_STATE_OUTGOING_ENQUEUED {
+		t.Fatalf("unexpected enqueued contact %v", enqueued)
+	}
+
+	bobContacts, err := bob.ListContacts(ctx, &messenger.ListContactsReq{})
+	if err != nil {
+		t.Fatalf("list contacts: %v", err)
+	}
+	if len(bobContacts.Contacts) != 1 {
+		t.Fatalf("expected 1 contact, got %v", bobContacts.Contacts)
+	}
+	accepted := bobContacts.Contacts[0]
+	if accepted.PublicKey != alicePK || accepted.Name != "a" || accepted.State != messenger.ContactState_CONTACT_STATE_ACCEPTED || accepted.Direction != messenger.ContactDirection_CONTACT_DIRECTION_INCOMING {
+		t.Fatalf("unexpected accepted contact %v", accepted)
+	}
+	if accepted.CreatedAt == 0 || accepted.UpdatedAt < accepted.CreatedAt {
+		t.Fatalf("unexpected timestamps %v", accepted)
+	}
+
+	again, err := bob.ListContacts(ctx, &messenger.ListContactsReq{})
+	if err != nil {
+		t.Fatalf("list contacts: %v", err)
+	}
+	if again.Contacts[0].CreatedAt != accepted.CreatedAt || again.Contacts[0].UpdatedAt != accepted.UpdatedAt {
+		t.Fatalf("timestamps changed across listings: %v, %v", accepted, again.Contacts[0])
+	}
+}
+
+func TestDiscardAndBlockContacts(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	ctx := testContext(t)
+	bob, carol, dave := newTestClient(t, nodes[0]), newTestClient(t, nodes[1]), newTestClient(t, nodes[2])
+	carolPK := base64.StdEncoding.EncodeToString(nodes[1].AccountPK)
+	davePK := base64.StdEncoding.EncodeToString(nodes[2].AccountPK)
+
+	bobRef, err := bob.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	for _, c := range []messenger.MessengerSvcClient{carol, dave} {
+		if _, err := c.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed}); err != nil {
+			t.Fatalf("send contact request: %v", err)
+		}
+	}
+
+	if _, err := bob.DiscardContactRequest(ctx, &messenger.DiscardContactRequestReq{Pubkey: carolPK}); err != nil {
+		t.Fatalf("discard: %v", err)
+	}
+	if _, err := bob.BlockContact(ctx, &messenger.BlockContactReq{Pubkey: davePK}); err != nil {
+		t.Fatalf("block: %v", err)
+	}
+
+	requests, err := bob.GetContactRequests(ctx, &messenger.GetContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("get contact requests: %v", err)
+	}
+	if len(requests.ContactRequests) != 0 {
+		t.Fatalf("expected no pending request, got %v", requests.ContactRequests)
+	}
+
+	contacts, err := bob.ListContacts(ctx, &messenger.ListContactsReq{})
+	if err != nil {
+		t.Fatalf("list contacts: %v", err)
+	}
+	if len(contacts.Contacts) != 2 {
+		t.Fatalf("expected 2 contacts, got %v", contacts.Contacts)
+	}
+	if c := contacts.Contacts[0]; c.PublicKey != carolPK || c.State != messenger.ContactState_CONTACT_STATE_DISCARDED {
+		t.Fatalf("unexpected discarded contact %v", c)
+	}
+	if c := contacts.Contacts[1]; c.PublicKey != davePK || !c.Blocked {
+		t.Fatalf("unexpected blocked contact %v", c)
+	}
+
+	if _, err := bob.UnblockContact(ctx, &messenger.UnblockContactReq{Pubkey: davePK}); err != nil {
+		t.Fatalf("unblock: %v", err)
+	}
+	requests, err = bob.GetContactRequests(ctx, &messenger.GetContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("get contact requests: %v", err)
+	}
+	if len(requests.ContactRequests) != 1 || requests.ContactRequests[0].PublicKey != davePK {
+		t.Fatalf("expected the unblocked request, got %v", requests.ContactRequests)
+	}
+}
+
+func TestOutgoingContactRequests(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK := base64.StdEncoding.EncodeToString(nodes[0].AccountPK)
+	bobPK := base64.StdEncoding.EncodeToString(nodes[1].AccountPK)
+
+	bobRef, err := bob.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	sent, err := alice.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed, Name: "alice"})
+	if err != nil {
+		t.Fatalf("send contact request: %v", err)
+	}
+	if sent.Status != messenger.OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_SENT {
+		t.Fatalf("expected sent status, got %v", sent.Status)
+	}
+
+	outgoing, err := alice.ListOutgoingContactRequests(ctx, &messenger.ListOutgoingContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("list outgoing: %v", err)
+	}
+	if len(outgoing.Requests) != 1 || outgoing.Requests[0].PublicKey != bobPK || outgoing.Requests[0].Status != messenger.OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_SENT {
+		t.Fatalf("unexpected outgoing requests %v", outgoing.Requests)
+	}
+
+	pending, err := alice.ListContacts(ctx, &messenger.ListContactsReq{ResolveAliasKeys: true})
+	if err != nil {
+		t.Fatalf("list contacts: %v", err)
+	}
+	if len(pending.Contacts) != 1 || pending.Contacts[0].State != messenger.ContactState_CONTACT_STATE_OUTGOING_SENT || pending.Contacts[0].AliasKeyAdded {
+		t.Fatalf("unexpected pending contacts %v", pending.Contacts)
+	}
+
+	if _, err := bob.AcceptContactRequest(ctx, &messenger.AcceptContactRequestReq{Pubkey: alicePK}); err != nil {
+		t.Fatalf("accept contact request: %v", err)
+	}
+
+	outgoing, err = alice.ListOutgoingContactRequests(ctx, &messenger.ListOutgoingContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("list outgoing: %v", err)
+	}
+	if len(outgoing.Requests) != 1 || outgoing.Requests[0].Status != messenger.OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_ACCEPTED {
+		t.Fatalf("expected accepted request, got %v", outgoing.Requests)
+	}
+
+	contacts, err := alice.ListContacts(ctx, &messenger.ListContactsReq{})
+	if err != nil {
+		t.Fatalf("list contacts: %v", err)
+	}
+	if len(contacts.Contacts) != 1 || contacts.Contacts[0].State != messenger.ContactState_CONTACT_STATE_ACCEPTED {
+		t.Fatalf("expected an accepted contact, got %v", contacts.Contacts)
+	}
+
+	incoming, err := bob.ListOutgoingContactRequests(ctx, &messenger.ListOutgoingContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("list outgoing: %v", err)
+	}
+	if len(incoming.Requests) != 0 {
+		t.Fatalf("expected no outgoing request for bob, got %v", incoming.Requests)
+	}
+}
+
+// failingLists fails the metadata listings.
+type failingLists struct {
+	protocoltypes.ProtocolServiceClient
+}
+
+func (failingLists) GroupMetadataList(context.Context, *protocoltypes.GroupMetadataList_Request, ...grpc.CallOption) (protocoltypes.ProtocolService_GroupMetadataListClient, error) {
+	return nil, status.Error(codes.Unavailable, "metadata unavailable")
+}
+
+func TestSendContactRequestUnknownStatus(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+
+	conn, err := nodes[0].Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial node: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	alice := serve(t, newTestService(t, nodes[0], messenger.WithProtocolClient(failingLists{protocoltypes.NewProtocolServiceClient(conn)})))
+
+	bobRef, err := newTestClient(t, nodes[1]).GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	sent, err := alice.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed})
+	if err != nil {
+		t.Fatalf("send contact request: %v", err)
+	}
+	if !sent.Success || sent.Status != messenger.OutgoingRequestStatus_OUTGOING_REQUEST_STATUS_UNKNOWN {
+		t.Fatalf("expected a successful request with an unknown status, got %v", sent)
+	}
+}
+
+func TestContactCards(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK := base64.StdEncoding.EncodeToString(nodes[0].AccountPK)
+	carolPK := base64.StdEncoding.EncodeToString(nodes[2].AccountPK)
+
+	bobRef, err := bob.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	card := &messenger.ContactCard{
+		Avatar:     []byte{0x89, 0x50},
+		AvatarRef:  "https://example.com/alice.png",
+		Attributes: map[string]string{"team": "support"},
+		Intro:      "about your ticket",
+	}
+	if _, err := alice.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed, Name: "alice", Card: card}); err != nil {
+		t.Fatalf("send contact request: %v", err)
+	}
+
+	// carol runs a version sending its name as a plain string.
+	conn, err := nodes[2].Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial node: %v", err)
+	}
+	defer conn.Close()
+	seed, _ := base64.StdEncoding.DecodeString(bobRef.RdvSeed)
+	if _, err := protocoltypes.NewProtocolServiceClient(conn).ContactRequestSend(ctx, &protocoltypes.ContactRequestSend_Request{
+		Contact:     &protocoltypes.ShareableContact{PK: nodes[1].AccountPK, PublicRendezvousSeed: seed},
+		OwnMetadata: []byte("carol"),
+	}); err != nil {
+		t.Fatalf("send legacy contact request: %v", err)
+	}
+
+	requests, err := bob.GetContactRequests(ctx, &messenger.GetContactRequestsReq{})
+	if err != nil {
+		t.Fatalf("get contact requests: %v", err)
+	}
+	if len(requests.ContactRequests) != 2 {
+		t.Fatalf("expected 2 requests, got %v", requests.ContactRequests)
+	}
+
+	got := requests.ContactRequests[0]
+	if got.PublicKey != alicePK || got.Name != "alice" || got.Card.GetVersion() != 1 || got.Card.GetIntro() != card.Intro ||
+		got.Card.GetAvatarRef() != card.AvatarRef || !bytes.Equal(got.Card.GetAvatar(), card.Avatar) || got.Card.GetAttributes()["team"] != "support" {
+		t.Fatalf("unexpected request %v", got)
+	}
+
+	legacy := requests.ContactRequests[1]
+	if legacy.PublicKey != carolPK || legacy.Name != "carol" || legacy.Card.GetVersion() != 0 || legacy.Card.GetDisplayName() != "carol" {
+		t.Fatalf("unexpected legacy request %v", legacy)
+	}
+}
+
+func TestWatchContactRequests(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	ctx := testContext(t)
+	alice, bob, carol := newTestClient(t, nodes[0]), newTestClient(t, nodes[1]), newTestClient(t, nodes[2])
+	alicePK := base64.StdEncoding.EncodeToString(nodes[0].AccountPK)
+	carolPK := base64.StdEncoding.EncodeToString(nodes[2].AccountPK)
+
+	bobRef, err := bob.GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	sendRequest := func(c messenger.MessengerSvcClient, name string) {
+		t.Helper()
+		if _, err := c.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed, Name: name}); err != nil {
+			t.Fatalf("send contact request: %v", err)
+		}
+	}
+	watch := func(req *messenger.WatchContactRequestsReq) messenger.MessengerSvc_WatchContactRequestsClient {
+		t.Helper()
+		watchCtx, cancel := context.WithCancel(ctx)
+		t.Cleanup(cancel)
+		w, err := bob.WatchContactRequests(watchCtx, req)
+		if err != nil {
+			t.Fatalf("watch: %v", err)
+		}
+		return w
+	}
+	expect := func(w messenger.MessengerSvc_WatchContactRequestsClient, eventType messenger.ContactRequestEventType, pk, name string) {
+		t.Helper()
+		event, err := w.Recv()
+		if err != nil {
+			t.Fatalf("recv: %v", err)
+		}
+		if event.Id == "" || event.Type != eventType || event.Contact.GetPublicKey() != pk || event.Contact.GetName() != name {
+			t.Fatalf("expected %v from %q, got %v", eventType, name, event)
+		}
+	}
+
+	sendRequest(carol, "carol")
+
+	live := watch(&messenger.WatchContactRequestsReq{})
+	replay := watch(&messenger.WatchContactRequestsReq{ReplayHistory: true})
+	expect(replay, messenger.ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_RECEIVED, carolPK, "carol")
+
+	if err := nodes[1].WaitLiveStreams(ctx, 2); err != nil {
+		t.Fatalf("wait watches: %v", err)
+	}
+
+	sendRequest(alice, "alice")
+	if _, err := bob.AcceptContactRequest(ctx, &messenger.AcceptContactRequestReq{Pubkey: alicePK}); err != nil {
+		t.Fatalf("accept: %v", err)
+	}
+	if _, err := bob.DiscardContactRequest(ctx, &messenger.DiscardContactRequestReq{Pubkey: carolPK}); err != nil {
+		t.Fatalf("discard: %v", err)
+	}
+
+	for _, w := range []messenger.MessengerSvc_WatchContactRequestsClient{live, replay} {
+		expect(w, messenger.ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_RECEIVED, alicePK, "alice")
+		expect(w, messenger.ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_ACCEPTED, alicePK, "alice")
+		expect(w, messenger.ContactRequestEventType_CONTACT_REQUEST_EVENT_TYPE_DISCARDED, carolPK, "carol")
+	}
+}
+
+func TestContactPolicy(t *testing.T) {
+	nodes := newTestNodes(t, 5)
+	ctx := testContext(t)
+	alice, carol, dave, erin := newTestClient(t, nodes[0]), newTestClient(t, nodes[2]), newTestClient(t, nodes[3]), newTestClient(t, nodes[4])
+	pks := make([]string, len(nodes))
+	for i, node := range nodes {
+		pks[i] = base64.StdEncoding.EncodeToString(node.AccountPK)
+	}
+
+	bobRef, err := newTestClient(t, nodes[1]).GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	sendRequest := func(c messenger.MessengerSvcClient, name string) {
+		t.Helper()
+		if _, err := c.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed, Name: name}); err != nil {
+			t.Fatalf("send contact request: %v", err)
+		}
+	}
+
+	// requests pending when the service starts are decided too.
+	sendRequest(alice, "alice")
+
+	bob := serve(t, newTestService(t, nodes[1], messenger.WithContactPolicy(&messenger.ContactPolicy{
+		Allow:      []string{pks[2]},
+		Rules:      []messenger.ContactRule{{Pattern: regexp.MustCompile(`(?i)spam`), Action: messenger.ContactAction_CONTACT_ACTION_DISCARD}},
+		MaxAccepts: 1,
+		Per:        time.Hour,
+		Default:    messenger.ContactAction_CONTACT_ACTION_ACCEPT,
+	})))
+
+	eventually(ctx, t, func() error {
+		res, err := bob.ListContactDecisions(ctx, &messenger.ListContactDecisionsReq{})
+		if err != nil {
+			return err
+		}
+		if len(res.Decisions) != 1 {
+			return fmt.Errorf("expected 1 decision, got %v", res.Decisions)
+		}
+		return nil
+	})
+	sendRequest(carol, "carol")
+	sendRequest(dave, "Spammer")
+	sendRequest(erin, "erin")
+
+	want := map[string]messenger.ContactAction{
+		pks[0]: messenger.ContactAction_CONTACT_ACTION_ACCEPT,
+		pks[2]: messenger.ContactAction_CONTACT_ACTION_ACCEPT,
+		pks[3]: messenger.ContactAction_CONTACT_ACTION_DISCARD,
+		pks[4]: messenger.ContactAction_CONTACT_ACTION_HOLD,
+	}
+	eventually(ctx, t, func() error {
+		res, err := bob.ListContactDecisions(ctx, &messenger.ListContactDecisionsReq{})
+		if err != nil {
+			return err
+		}
+		if len(res.Decisions) != len(want) {
+			return fmt.Errorf("expected %d decisions, got %v", len(want), res.Decisions)
+		}
+		for _, decision := range res.Decisions {
+			if decision.Action != want[decision.PublicKey] {
+				return fmt.Errorf("unexpected decision %v", decision)
+			}
+		}
+		return nil
+	})
+
+	contacts, err := bob.ListContacts(ctx, &messenger.ListContactsReq{})
+	if err != nil {
+		t.Fatalf("list contacts: %v", err)
+	}
+	states := map[string]messenger.ContactState{}
+	for _, contact := range contacts.Contacts {
+		states[contact.PublicKey] = contact.State
+	}
+	if states[pks[0]] != messenger.ContactState_CONTACT_STATE_ACCEPTED || states[pks[2]] != messenger.ContactState_CONTACT_STATE_ACCEPTED ||
+		states[pks[3]] != messenger.ContactState_CONTACT_STATE_DISCARDED || states[pks[4]] != messenger.ContactState_CONTACT_STATE_INCOMING_RECEIVED {
+		t.Fatalf("unexpected contact states %v", states)
+	}
+}
+
+func TestContactPolicyValidation(t *testing.T) {
+	node := newTestNodes(t, 1)[0]
+	for name, p := range map[string]*messenger.ContactPolicy{
+		"no pattern":     {Rules: []messenger.ContactRule{{Action: messenger.ContactAction_CONTACT_ACTION_DISCARD}}},
+		"no rate period": {MaxAccepts: 1},
+	} {
+		_, err := messenger.NewWithOptions(context.Background(),
+			messenger.WithNodeAddr(messengertest.Addr),
+			messenger.WithDialOptions(node.DialOption()),
+			messenger.WithContactPolicy(p),
+		)
+		if err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestContactPolicyHeldRequests(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	ctx := testContext(t)
+	alicePK := base64.StdEncoding.EncodeToString(nodes[0].AccountPK)
+	carolPK := base64.StdEncoding.EncodeToString(nodes[2].AccountPK)
+
+	bobRef, err := newTestClient(t, nodes[1]).GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	for _, node := range []*messengertest.Node{nodes[0], nodes[2]} {
+		if _, err := newTestClient(t, node).SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed}); err != nil {
+			t.Fatalf("send contact request: %v", err)
+		}
+	}
+
+	decisions := func(c messenger.MessengerSvcClient, want map[string]string) func() error {
+		return func() error {
+			res, err := c.ListContactDecisions(ctx, &messenger.ListContactDecisionsReq{})
+			if err != nil {
+				return err
+			}
+			got := map[string]string{}
+			for _, decision := range res.Decisions {
+				got[decision.PublicKey] = fmt.Sprintf("%s %s", decision.Action, decision.Reason)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				return fmt.Errorf("expected decisions %v, got %v", want, got)
+			}
+			return nil
+		}
+	}
+
+	store := dssync.MutexWrap(datastore.NewMapDatastore())
+	holding := newTestService(t, nodes[1], messenger.WithDatastore(store), messenger.WithContactPolicy(&messenger.ContactPolicy{}))
+	eventually(ctx, t, decisions(serve(t, holding), map[string]string{
+		alicePK: "CONTACT_ACTION_HOLD default",
+		carolPK: "CONTACT_ACTION_HOLD default",
+	}))
+	if err := holding.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	// held requests are decided again on start, and once the rate cap frees up
+	bob := serve(t, newTestService(t, nodes[1], messenger.WithDatastore(store), messenger.WithContactPolicy(&messenger.ContactPolicy{
+		MaxAccepts: 1,
+		Per:        time.Second,
+		Default:    messenger.ContactAction_CONTACT_ACTION_ACCEPT,
+	})))
+	eventually(ctx, t, decisions(bob, map[string]string{
+		alicePK: "CONTACT_ACTION_ACCEPT default",
+		carolPK: "CONTACT_ACTION_HOLD rate limited",
+	}))
+	eventually(ctx, t, decisions(bob, map[string]string{
+		alicePK: "CONTACT_ACTION_ACCEPT default",
+		carolPK: "CONTACT_ACTION_ACCEPT default",
+	}))
+}
+
+// failingAccepts fails the acceptance of the requests from contactPK.
+type failingAccepts struct {
+	protocoltypes.ProtocolServiceClient
+	contactPK []byte
+}
+
+func (c failingAccepts) ContactRequestAccept(ctx context.Context, in *protocoltypes.ContactRequestAccept_Request, opts ...grpc.CallOption) (*protocoltypes.ContactRequestAccept_Reply, error) {
+	if bytes.Equal(in.ContactPK, c.contactPK) {
+		return nil, status.Error(codes.Unavailable, "contact unreachable")
+	}
+	return c.ProtocolServiceClient.ContactRequestAccept(ctx, in, opts...)
+}
+
+func TestContactPolicyFailures(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	ctx := testContext(t)
+	alice, carol := newTestClient(t, nodes[0]), newTestClient(t, nodes[2])
+	alicePK := base64.StdEncoding.EncodeToString(nodes[0].AccountPK)
+	carolPK := base64.StdEncoding.EncodeToString(nodes[2].AccountPK)
+
+	bobRef, err := newTestClient(t, nodes[1]).GetContactPubkey(ctx, &messenger.GetContactPubkeyReq{})
+	if err != nil {
+		t.Fatalf("get pubkey: %v", err)
+	}
+	for _, c := range []messenger.MessengerSvcClient{carol, alice} {
+		if _, err := c.SendContactRequest(ctx, &messenger.SendContactRequestReq{Pubkey: bobRef.Pubkey, RdvSeed: bobRef.RdvSeed}); err != nil {
+			t.Fatalf("send contact request: %v", err)
+		}
+	}
+
+	conn, err := nodes[1].Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial node: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	bob := serve(t, newTestService(t, nodes[1],
+		messenger.WithProtocolClient(failingAccepts{protocoltypes.NewProtocolServiceClient(conn), nodes[2].AccountPK}),
+		messenger.WithContactPolicy(&messenger.ContactPolicy{Default: messenger.ContactAction_CONTACT_ACTION_ACCEPT}),
+	))
+
+	// the failing request is held, and does not stop the requests behind it.
+	eventually(ctx, t, func() error {
+		res, err := bob.ListContactDecisions(ctx, &messenger.ListContactDecisionsReq{})
+		if err != nil {
+			return err
+		}
+		if len(res.Decisions) != 2 {
+			return fmt.Errorf("expected 2 decisions, got %v", res.Decisions)
+		}
+		for _, decision := range res.Decisions {
+			switch {
+			case decision.PublicKey == carolPK && decision.Action == messenger.ContactAction_CONTACT_ACTION_HOLD && strings.HasPrefix(decision.Reason, "accept failed"):
+			case decision.PublicKey == alicePK && decision.Action == messenger.ContactAction_CONTACT_ACTION_ACCEPT:
+			default:
+				return fmt.Errorf("unexpected decision %v", decision)
+			}
+		}
+		return nil
+	})
+}
+
+func TestSafetyNumbers(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK, bobPK := addContact(ctx, t, alice, bob)
+
+	aliceNumber, err := alice.GetSafetyNumber(ctx, &messenger.GetSafetyNumberReq{Pubkey: bobPK})
+	if err != nil {
+		t.Fatalf("get safety number: %v", err)
+	}
+	bobNumber, err := bob.GetSafetyNumber(ctx, &messenger.GetSafetyNumberReq{Pubkey: alicePK})
+	if err != nil {
+		t.Fatalf("get safety number: %v", err)
+	}
+	if aliceNumber.SafetyNumber != bobNumber.SafetyNumber {
+		t.Fatalf("safety numbers differ: %q and %q", aliceNumber.SafetyNumber, bobNumber.SafetyNumber)
+	}
+	if groups := strings.Fields(aliceNumber.SafetyNumber); len(groups) != 12 || len(strings.Join(groups, "")) != 60 {
+		t.Fatalf("unexpected safety number %q", aliceNumber.SafetyNumber)
+	}
+	if aliceNumber.Verified {
+		t.Fatal("expected an unverified contact")
+	}
+
+	verify := func(verified bool) {
+		t.Helper()
+		if _, err := alice.SetContactVerified(ctx, &messenger.SetContactVerifiedReq{Pubkey: bobPK, Verified: verified}); err != nil {
+			t.Fatalf("set verified: %v", err)
+		}
+		contacts, err := alice.ListContacts(ctx, &messenger.ListContactsReq{})
+		if err != nil {
+			t.Fatalf("list contacts: %v", err)
+		}
+		if len(contacts.Contacts) != 1 || contacts.Contacts[0].Verified != verified {
+			t.Fatalf("expected verified %v, got %v", verified, contacts.Contacts)
+		}
+	}
+	verify(true)
+	verify(false)
+}
+
+func TestContactDirectory(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+	alicePK, bobPK := addContact(ctx, t, alice, bob)
+
+	entry := &messenger.ContactEntry{PublicKey: bobPK, Nickname: "bobby", Tags: []string{"vip", "support"}, Notes: "met at the meetup"}
+	if _, err := alice.SetContactEntry(ctx, &messenger.SetContactEntryReq{Entry: entry}); err != nil {
+		t.Fatalf("set entry: %v", err)
+	}
+	if _, err := alice.SetContactEntry(ctx, &messenger.SetContactEntryReq{Entry: &messenger.ContactEntry{PublicKey: alicePK, Nickname: "bobby"}}); err == nil {
+		t.Fatal("expected an error for a duplicate nickname")
+	}
+
+	got, err := alice.GetContactEntry(ctx, &messenger.GetContactEntryReq{Pubkey: bobPK})
+	if err != nil {
+		t.Fatalf("get entry: %v", err)
+	}
+	if got.Entry.Nickname != "bobby" || got.Entry.Notes != entry.Notes {
+		t.Fatalf("unexpected entry %v", got.Entry)
+	}
+	for tag, want := range map[string]int{"vip": 1, "other": 0, "": 1} {
+		listed, err := alice.ListContactEntries(ctx, &messenger.ListContactEntriesReq{Tag: tag})
+		if err != nil {
+			t.Fatalf("list entries: %v", err)
+		}
+		if len(listed.Entries) != want {
+			t.Fatalf("expected %d entries tagged %q, got %v", want, tag, listed.Entries)
+		}
+	}
+
+	contacts, err := alice.ListContacts(ctx, &messenger.ListContactsReq{})
+	if err != nil {
+		t.Fatalf("list contacts: %v", err)
+	}
+	if c := contacts.Contacts[0]; c.Nickname != "bobby" || len(c.Tags) != 2 || c.Notes != entry.Notes {
+		t.Fatalf("expected the entry merged in the contact, got %v", c)
+	}
+
+	if _, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Nickname: "bobby", Message: "hi bobby"}); err != nil {
+		t.Fatalf("send message: %v", err)
+	}
+	if _, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Nickname: "nobody", Message: "hi"}); err == nil {
+		t.Fatal("expected an error for an unknown nickname")
+	}
+	msgs := listMessages(ctx, t, bob, &messenger.ListMessagesReq{Pubkey: alicePK, IsContact: true})
+	if len(msgs) != 1 || msgs[0].Message != "hi bobby" {
+		t.Fatalf("unexpected messages %v", msgs)
+	}
+
+	if _, err := alice.DeleteContactEntry(ctx, &messenger.DeleteContactEntryReq{Pubkey: bobPK}); err != nil {
+		t.Fatalf("delete entry: %v", err)
+	}
+	if _, err := alice.GetContactEntry(ctx, &messenger.GetContactEntryReq{Pubkey: bobPK}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for a deleted entry, got %v", err)
+	}
+}
+
+func TestListGroups(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+
+	created, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	joined, err := bob.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: created.GroupInvitation})
+	if err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+	if joined.GroupPk != created.GroupPk {
+		t.Fatalf("expected group %q, got %q", created.GroupPk, joined.GroupPk)
+	}
+
+	for _, c := range []messenger.MessengerSvcClient{alice, bob} {
+		res, err := c.ListGroups(ctx, &messenger.ListGroupsReq{})
+		if err != nil {
+			t.Fatalf("list groups: %v", err)
+		}
+		if len(res.Groups) != 1 {
+			t.Fatalf("expected 1 group, got %v", res.Groups)
+		}
+		if g := res.Groups[0]; g.PublicKey != created.GroupPk || g.Type != messenger.GroupType_GROUP_TYPE_MULTI_MEMBER || !g.Active || g.MemberPk == "" || g.JoinedAt == 0 {
+			t.Fatalf("unexpected group %v", g)
+		}
+	}
+
+	// a restarted client without the module state rediscovers the group.
+	res, err := newTestClient(t, nodes[0]).ListGroups(ctx, &messenger.ListGroupsReq{})
+	if err != nil {
+		t.Fatalf("list groups: %v", err)
+	}
+	if len(res.Groups) != 1 || res.Groups[0].PublicKey != created.GroupPk || res.Groups[0].Active {
+		t.Fatalf("unexpected groups %v", res.Groups)
+	}
+
+	// a group joined outside of the module is listed as inactive.
+	invitation, _ := base64.StdEncoding.DecodeString(created.GroupInvitation)
+	group := &protocoltypes.Group{}
+	if err := group.Unmarshal(invitation); err != nil {
+		t.Fatalf("unmarshal invitation: %v", err)
+	}
+	conn, err := nodes[2].Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial node: %v", err)
+	}
+	defer conn.Close()
+	if _, err := protocoltypes.NewProtocolServiceClient(conn).MultiMemberGroupJoin(ctx, &protocoltypes.MultiMemberGroupJoin_Request{Group: group}); err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+	res, err = newTestClient(t, nodes[2]).ListGroups(ctx, &messenger.ListGroupsReq{})
+	if err != nil {
+		t.Fatalf("list groups: %v", err)
+	}
+	if len(res.Groups) != 1 || res.Groups[0].Active || res.Groups[0].MemberPk == "" {
+		t.Fatalf("unexpected groups %v", res.Groups)
+	}
+}
+
+func TestGroupsReactivated(t *testing.T) {
+	nodes := newTestNodes(t, 1)
+	ctx := testContext(t)
+
+	store := dssync.MutexWrap(datastore.NewMapDatastore())
+	first := newTestService(t, nodes[0], messenger.WithDatastore(store))
+	created, err := first.CreateGroup(ctx, &messenger.CreateGroupReq{})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	if err := first.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	// the node forgets the active groups when it restarts
+	conn, err := nodes[0].Dial(ctx)
+	if err != nil {
+		t.Fatalf("dial node: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	groupPK, err := base64.StdEncoding.DecodeString(created.GroupPk)
+	if err != nil {
+		t.Fatalf("decode group key: %v", err)
+	}
+	if _, err := protocoltypes.NewProtocolServiceClient(conn).DeactivateGroup(ctx, &protocoltypes.DeactivateGroup_Request{GroupPK: groupPK}); err != nil {
+		t.Fatalf("deactivate group: %v", err)
+	}
+
+	alice := serve(t, newTestService(t, nodes[0], messenger.WithDatastore(store)))
+	eventually(ctx, t, func() error {
+		_, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: created.GroupPk, Message: "hello"})
+		return err
+	})
+}
+
+func TestLeaveGroup(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+
+	created, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	if _, err := bob.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: created.GroupInvitation}); err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+	if _, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: created.GroupPk, Message: "hello"}); err != nil {
+		t.Fatalf("send message: %v", err)
+	}
+	if msgs := listMessages(ctx, t, bob, &messenger.ListMessagesReq{Pubkey: created.GroupPk}); len(msgs) != 1 {
+		t.Fatalf("expected 1 message, got %v", msgs)
+	}
+
+	sub, err := bob.SubscribeMessages(ctx, &messenger.SubscribeMessagesReq{Pubkey: created.GroupPk})
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	watch, err := bob.WatchGroupMembers(ctx, &messenger.WatchGroupMembersReq{Pubkey: created.GroupPk})
+	if err != nil {
+		t.Fatalf("watch members: %v", err)
+	}
+	if err := nodes[1].WaitLiveStreams(ctx, 2); err != nil {
+		t.Fatalf("wait streams: %v", err)
+	}
+
+	left, err := bob.LeaveGroup(ctx, &messenger.LeaveGroupReq{GroupPk: created.GroupPk})
+	if err != nil {
+		t.Fatalf("leave group: %v", err)
+	}
+	if !left.WasActive || left.CachedDevices == 0 || left.ClosedSubscriptions != 2 {
+		t.Fatalf("unexpected cleanup %v", left)
+	}
+	if _, err := sub.Recv(); err != io.EOF {
+		t.Fatalf("expected the subscription to end, got %v", err)
+	}
+	if _, err := watch.Recv(); err != io.EOF {
+		t.Fatalf("expected the member watch to end, got %v", err)
+	}
+
+	res, err := bob.ListGroups(ctx, &messenger.ListGroupsReq{})
+	if err != nil {
+		t.Fatalf("list groups: %v", err)
+	}
+	if len(res.Groups) != 0 {
+		t.Fatalf("expected no group, got %v", res.Groups)
+	}
+	res, err = bob.ListGroups(ctx, &messenger.ListGroupsReq{IncludeLeft: true})
+	if err != nil {
+		t.Fatalf("list groups: %v", err)
+	}
+	if len(res.Groups) != 1 || !res.Groups[0].Left || res.Groups[0].Active {
+		t.Fatalf("expected the left group, got %v", res.Groups)
+	}
+
+	if _, err := bob.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: created.GroupPk, Message: "still here"}); err == nil {
+		t.Fatal("expected an error sending to a left group")
+	}
+
+	// a failed leave keeps the group usable
+	alicePK, _ := addContact(ctx, t, alice, bob)
+	contactGroup, err := nodes[1].GroupInfo(ctx, &protocoltypes.GroupInfo_Request{ContactPK: nodes[0].AccountPK})
+	if err != nil {
+		t.Fatalf("contact group info: %v", err)
+	}
+	if _, err := bob.LeaveGroup(ctx, &messenger.LeaveGroupReq{GroupPk: base64.StdEncoding.EncodeToString(contactGroup.Group.PublicKey)}); err == nil {
+		t.Fatal("expected an error leaving a contact group")
+	}
+	if _, err := bob.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: alicePK, IsContact: true, Message: "still here"}); err != nil {
+		t.Fatalf("send message: %v", err)
+	}
+}
+
+func TestGroupMembers(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	ctx := testContext(t)
+	alice, bob, carol := newTestClient(t, nodes[0]), newTestClient(t, nodes[1]), newTestClient(t, nodes[2])
+
+	created, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	if _, err := bob.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: created.GroupInvitation}); err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+
+	res, err := alice.ListGroupMembers(ctx, &messenger.ListGroupMembersReq{Pubkey: created.GroupPk})
+	if err != nil {
+		t.Fatalf("list members: %v", err)
+	}
+	if len(res.Members) != 2 {
+		t.Fatalf("expected 2 members, got %v", res.Members)
+	}
+	creator, joiner := res.Members[0], res.Members[1]
+	if !creator.Admin || !creator.Self || len(creator.DevicePks) != 1 || creator.DevicePks[0] != base64.StdEncoding.EncodeToString(nodes[0].DevicePK) || creator.FirstSeenAt == 0 {
+		t.Fatalf("unexpected creator %v", creator)
+	}
+	if joiner.Admin || joiner.Self || len(joiner.DevicePks) != 1 || joiner.DevicePks[0] != base64.StdEncoding.EncodeToString(nodes[1].DevicePK) {
+		t.Fatalf("unexpected joiner %v", joiner)
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	live, err := alice.WatchGroupMembers(watchCtx, &messenger.WatchGroupMembersReq{Pubkey: created.GroupPk})
+	if err != nil {
+		t.Fatalf("watch members: %v", err)
+	}
+	replay, err := alice.WatchGroupMembers(watchCtx, &messenger.WatchGroupMembersReq{Pubkey: created.GroupPk, ReplayHistory: true})
+	if err != nil {
+		t.Fatalf("watch members: %v", err)
+	}
+	for _, want := range []messenger.GroupMemberEventType{
+		messenger.GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_MEMBER_JOINED,
+		messenger.GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_ADMIN_GRANTED,
+		messenger.GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_MEMBER_JOINED,
+	} {
+		event, err := replay.Recv()
+		if err != nil {
+			t.Fatalf("recv: %v", err)
+		}
+		if event.Type != want {
+			t.Fatalf("expected %v, got %v", want, event)
+		}
+	}
+
+	if err := nodes[0].WaitLiveStreams(ctx, 2); err != nil {
+		t.Fatalf("wait watches: %v", err)
+	}
+
+	if _, err := carol.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: created.GroupInvitation}); err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+	for _, w := range []messenger.MessengerSvc_WatchGroupMembersClient{live, replay} {
+		event, err := w.Recv()
+		if err != nil {
+			t.Fatalf("recv: %v", err)
+		}
+		if event.Type != messenger.GroupMemberEventType_GROUP_MEMBER_EVENT_TYPE_MEMBER_JOINED || event.Id == "" ||
+			event.DevicePk != base64.StdEncoding.EncodeToString(nodes[2].DevicePK) || event.Member.GetAdmin() {
+			t.Fatalf("unexpected event %v", event)
+		}
+	}
+}
+
+func TestGroupProfile(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	alice, bob := newTestClient(t, nodes[0]), newTestClient(t, nodes[1])
+
+	created, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{Name: "incident-42", Description: "database outage", Avatar: []byte{1, 2, 3}})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	if _, err := bob.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: created.GroupInvitation}); err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+
+	members, err := alice.ListGroupMembers(ctx, &messenger.ListGroupMembersReq{Pubkey: created.GroupPk})
+	if err != nil {
+		t.Fatalf("list members: %v", err)
+	}
+	aliceMember, bobMember := members.Members[0].MemberPk, members.Members[1].MemberPk
+
+	got, err := bob.GetGroupProfile(ctx, &messenger.GetGroupProfileReq{GroupPk: created.GroupPk})
+	if err != nil {
+		t.Fatalf("get profile: %v", err)
+	}
+	if got.Profile.Name != "incident-42" || got.Profile.Description != "database outage" || !bytes.Equal(got.Profile.Avatar, []byte{1, 2, 3}) || got.UpdatedBy != aliceMember {
+		t.Fatalf("unexpected profile %v", got)
+	}
+
+	updated, err := bob.UpdateGroupProfile(ctx, &messenger.UpdateGroupProfileReq{GroupPk: created.GroupPk, Profile: &messenger.GroupProfile{Description: "resolved"}})
+	if err != nil {
+		t.Fatalf("update profile: %v", err)
+	}
+	if updated.Profile.Name != "incident-42" || updated.Profile.Description != "resolved" {
+		t.Fatalf("unexpected updated profile %v", updated.Profile)
+	}
+
+	got, err = alice.GetGroupProfile(ctx, &messenger.GetGroupProfileReq{GroupPk: created.GroupPk})
+	if err != nil {
+		t.Fatalf("get profile: %v", err)
+	}
+	if got.Profile.Description != "resolved" || got.UpdatedBy != bobMember {
+		t.Fatalf("unexpected profile %v", got)
+	}
+
+	groups, err := alice.ListGroups(ctx, &messenger.ListGroupsReq{})
+	if err != nil {
+		t.Fatalf("list groups: %v", err)
+	}
+	if len(groups.Groups) != 1 || groups.Groups[0].Profile.GetName() != "incident-42" {
+		t.Fatalf("expected the profile in the group list, got %v", groups.Groups)
+	}
+}
+
+func TestGroupAdmins(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	policy := messenger.WithAdminPolicy(&messenger.AdminPolicy{Profile: true, Invitations: true, Announcements: true})
+	alice, bob := serve(t, newTestService(t, nodes[0], policy)), serve(t, newTestService(t, nodes[1], policy))
+
+	created, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{Name: "announcements"})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	if _, err := bob.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: created.GroupInvitation}); err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+	if _, err := alice.SetGroupSettings(ctx, &messenger.SetGroupSettingsReq{GroupPk: created.GroupPk, Settings: &messenger.GroupSettings{AnnouncementOnly: true}}); err != nil {
+		t.Fatalf("set settings: %v", err)
+	}
+	if _, err := alice.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: created.GroupPk, Message: "welcome"}); err != nil {
+		t.Fatalf("send message: %v", err)
+	}
+
+	denied := func(op string, err error) {
+		t.Helper()
+		if status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("%s: expected PermissionDenied, got %v", op, err)
+		}
+	}
+	_, err = bob.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: created.GroupPk, Message: "hi"})
+	denied("send message", err)
+	_, err = bob.UpdateGroupProfile(ctx, &messenger.UpdateGroupProfileReq{GroupPk: created.GroupPk, Profile: &messenger.GroupProfile{Name: "mine"}})
+	denied("update profile", err)
+	_, err = bob.CreateGroupInvitation(ctx, &messenger.CreateGroupInvitationReq{GroupPk: created.GroupPk})
+	denied("create invitation", err)
+
+	members, err := alice.ListGroupMembers(ctx, &messenger.ListGroupMembersReq{Pubkey: created.GroupPk})
+	if err != nil {
+		t.Fatalf("list members: %v", err)
+	}
+	bobMember := members.Members[1].MemberPk
+	if _, err := bob.GrantGroupAdmin(ctx, &messenger.GrantGroupAdminReq{GroupPk: created.GroupPk, MemberPk: bobMember}); err == nil {
+		t.Fatal("expected an error granting the admin role as a non admin")
+	}
+	if _, err := alice.GrantGroupAdmin(ctx, &messenger.GrantGroupAdminReq{GroupPk: created.GroupPk, MemberPk: bobMember}); err != nil {
+		t.Fatalf("grant admin: %v", err)
+	}
+
+	admins, err := bob.ListGroupAdmins(ctx, &messenger.ListGroupAdminsReq{GroupPk: created.GroupPk})
+	if err != nil {
+		t.Fatalf("list admins: %v", err)
+	}
+	if len(admins.Admins) != 2 || admins.Admins[1].MemberPk != bobMember {
+		t.Fatalf("unexpected admins %v", admins.Admins)
+	}
+
+	if _, err := bob.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: created.GroupPk, Message: "hi"}); err != nil {
+		t.Fatalf("send message as admin: %v", err)
+	}
+	if _, err := bob.CreateGroupInvitation(ctx, &messenger.CreateGroupInvitationReq{GroupPk: created.GroupPk}); err != nil {
+		t.Fatalf("create invitation as admin: %v", err)
+	}
+
+	profile, err := bob.GetGroupProfile(ctx, &messenger.GetGroupProfileReq{GroupPk: created.GroupPk})
+	if err != nil {
+		t.Fatalf("get profile: %v", err)
+	}
+	if !profile.Settings.GetAnnouncementOnly() {
+		t.Fatalf("expected announcement only settings, got %v", profile.Settings)
+	}
+}
+
+func TestGroupSettingsAdmins(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	policy := messenger.WithAdminPolicy(&messenger.AdminPolicy{Announcements: true})
+	alice, bob := serve(t, newTestService(t, nodes[0], policy)), serve(t, newTestService(t, nodes[1], policy))
+	unrestricted := serve(t, newTestService(t, nodes[1]))
+
+	created, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{Name: "announcements"})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	if _, err := bob.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: created.GroupInvitation}); err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+	if _, err := alice.SetGroupSettings(ctx, &messenger.SetGroupSettingsReq{GroupPk: created.GroupPk, Settings: &messenger.GroupSettings{AnnouncementOnly: true}}); err != nil {
+		t.Fatalf("set settings: %v", err)
+	}
+
+	_, err = bob.SetGroupSettings(ctx, &messenger.SetGroupSettingsReq{GroupPk: created.GroupPk, Settings: &messenger.GroupSettings{}})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("set settings: expected PermissionDenied, got %v", err)
+	}
+
+	// settings published by a non admin member are ignored
+	if _, err := unrestricted.SetGroupSettings(ctx, &messenger.SetGroupSettingsReq{GroupPk: created.GroupPk, Settings: &messenger.GroupSettings{}}); err != nil {
+		t.Fatalf("set settings: %v", err)
+	}
+	profile, err := alice.GetGroupProfile(ctx, &messenger.GetGroupProfileReq{GroupPk: created.GroupPk})
+	if err != nil {
+		t.Fatalf("get profile: %v", err)
+	}
+	if !profile.Settings.GetAnnouncementOnly() {
+		t.Fatalf("expected announcement only settings, got %v", profile.Settings)
+	}
+	_, err = bob.SendMessage(ctx, &messenger.SendMessageReq{Pubkey: created.GroupPk, Message: "hi"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("send message: expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestGroupProfileAdmins(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	ctx := testContext(t)
+	policy := messenger.WithAdminPolicy(&messenger.AdminPolicy{Profile: true})
+	alice, bob := serve(t, newTestService(t, nodes[0], policy)), serve(t, newTestService(t, nodes[1], policy))
+	unrestricted := serve(t, newTestService(t, nodes[1]))
+
+	created, err := alice.CreateGroup(ctx, &messenger.CreateGroupReq{Name: "announcements"})
+	if err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	if _, err := bob.JoinGroup(ctx, &messenger.JoinGroupReq{GroupInvitation: created.GroupInvitation}); err != nil {
+		t.Fatalf("join group: %v", err)
+	}
+
+	// profiles published by a non admin member are ignored
+	if _, err := unrestricted.UpdateGroupProfile(ctx, &messenger.UpdateGroupProfileReq{GroupPk: created.GroupPk, Profile: &messenger.GroupProfile{Name: "mine"}}); err != nil {
+		t.Fatalf("update profile: %v", err)
+	}
+	for _, c := range []messenger.MessengerSvcClient{alice, bob} {
+		got, err := c.GetGroupProfile(ctx, &messenger.GetGroupProfileReq{GroupPk: created.GroupPk})
+		if err != nil {
+			t.Fatalf("get profile: %v", err)
+		}
+		if got.Profile.GetName() != "announcements" {
+			t.Fatalf("expected the admin profile, got %v", got)
+		}
+	}
+
+	if got, err := unrestricted.GetGroupProfile(ctx, &messenger.GetGroupProfileReq{GroupPk: created.GroupPk}); err != nil || got.Profile.GetName() != "mine" {
+		t.Fatalf("expected the last profile without policy, got %v, %v", got, err)
+	}
+}
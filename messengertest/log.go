@@ -0,0 +1,200 @@
+package messengertest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	cid "github.com/ipfs/go-cid"
+	multihash "github.com/multiformats/go-multihash"
+)
+
+// groupLog holds the metadata and message logs of a group, shared by every
+// node of the network.
+type groupLog struct {
+	group    *protocoltypes.Group
+	metadata eventLog[*protocoltypes.GroupMetadataEvent]
+	messages eventLog[*protocoltypes.GroupMessageEvent]
+	counters map[string]uint64
+	admins   map[string]bool
+}
+
+func newGroupLog(group *protocoltypes.Group) *groupLog {
+	return &groupLog{
+		group:    group,
+		metadata: newEventLog[*protocoltypes.GroupMetadataEvent](),
+		messages: newEventLog[*protocoltypes.GroupMessageEvent](),
+		counters: make(map[string]uint64),
+		admins:   make(map[string]bool),
+	}
+}
+
+func (g *groupLog) addMetadata(eventType protocoltypes.EventType, event interface{ Marshal() ([]byte, error) }) ([]byte, error) {
+	payload, err := event.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	id := newEventID()
+	g.metadata.append(id, &protocoltypes.GroupMetadataEvent{
+		EventContext: &protocoltypes.EventContext{
+			ID:        id,
+			ParentIDs: g.metadata.head(),
+			GroupPK:   g.group.PublicKey,
+		},
+		Metadata: &protocoltypes.GroupMetadata{
+			EventType: eventType,
+			Payload:   payload,
+		},
+		Event: payload,
+	})
+	return id, nil
+}
+
+func (g *groupLog) addMessage(devicePK, payload []byte) []byte {
+	g.counters[key(devicePK)]++
+
+	id := newEventID()
+	g.messages.append(id, &protocoltypes.GroupMessageEvent{
+		EventContext: &protocoltypes.EventContext{
+			ID:        id,
+			ParentIDs: g.messages.head(),
+			GroupPK:   g.group.PublicKey,
+		},
+		Headers: &protocoltypes.MessageHeaders{
+			Counter:  g.counters[key(devicePK)],
+			DevicePK: devicePK,
+		},
+		Message: payload,
+	})
+	return id
+}
+
+// newEventID returns a random CID, the protocol using the CID of the log
+// entries as event IDs.
+func newEventID() []byte {
+	hash, err := multihash.Sum(randBytes(), multihash.SHA2_256, -1)
+	if err != nil {
+		panic(err)
+	}
+	return cid.NewCidV1(cid.DagCBOR, hash).Bytes()
+}
+
+type eventLog[T any] struct {
+	ids     [][]byte
+	events  []T
+	changed chan struct{}
+}
+
+func newEventLog[T any]() eventLog[T] {
+	return eventLog[T]{changed: make(chan struct{})}
+}
+
+func (l *eventLog[T]) append(id []byte, event T) {
+	l.ids = append(l.ids, id)
+	l.events = append(l.events, event)
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+func (l *eventLog[T]) head() [][]byte {
+	if len(l.ids) == 0 {
+		return nil
+	}
+	return [][]byte{l.ids[len(l.ids)-1]}
+}
+
+func (l *eventLog[T]) index(id []byte) int {
+	for i, v := range l.ids {
+		if bytes.Equal(v, id) {
+			return i
+		}
+	}
+	return -1
+}
+
+type listParams struct {
+	sinceID  []byte
+	sinceNow bool
+	untilID  []byte
+	untilNow bool
+	reverse  bool
+}
+
+// stream sends the events of l selected by p. Unless p.untilNow is set, it
+// then keeps sending the later events until ctx is done, counted in live
+// meanwhile.
+func stream[T any](ctx context.Context, mu *sync.Mutex, l *eventLog[T], p listParams, live *int, send func(T) error) error {
+	mu.Lock()
+	events := append([]T(nil), l.events...)
+	start, end := 0, len(events)
+	switch {
+	case p.sinceNow:
+		start = len(events)
+	case p.sinceID != nil:
+		start = l.index(p.sinceID)
+	}
+	if p.untilID != nil {
+		end = l.index(p.untilID) + 1
+	}
+	mu.Unlock()
+
+	if start < 0 || end < 1 && p.untilID != nil {
+		return errors.New("unknown event id")
+	}
+	// as in the protocol, only untilNow bounds the list: events past untilID
+	// are streamed live
+	bounded := p.untilNow
+	if p.reverse && !bounded {
+		return errors.New("reverse order requires an upper bound")
+	}
+
+	if p.reverse {
+		for i := end - 1; i >= start; i-- {
+			if err := send(events[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i := start; i < end; i++ {
+		if err := send(events[i]); err != nil {
+			return err
+		}
+	}
+	if bounded {
+		return nil
+	}
+
+	mu.Lock()
+	*live++
+	mu.Unlock()
+	defer func() {
+		mu.Lock()
+		*live--
+		mu.Unlock()
+	}()
+
+	for next := end; ; {
+		mu.Lock()
+		events = append([]T(nil), l.events[next:]...)
+		changed := l.changed
+		mu.Unlock()
+
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		next += len(events)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
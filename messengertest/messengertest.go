@@ -0,0 +1,172 @@
+// Package messengertest provides an in-memory fake of Berty nodes, to test
+// code built on protocoltypes.ProtocolServiceClient without a live node.
+//
+// Nodes created on the same Network can exchange contact requests, share
+// contact and multi-member groups and read each other's messages. The fake
+// models the protocol closely enough to drive the messenger service; it does
+// no cryptography and does not persist anything.
+package messengertest
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Addr is the address to dial a Node with, along with Node.DialOption.
+const Addr = "bufconn"
+
+const bufSize = 1 << 20
+
+// Network is a set of fake nodes able to reach each other.
+type Network struct {
+	mu            sync.Mutex
+	nodes         []*Node
+	groups        map[string]*groupLog
+	contactGroups map[string]*groupLog
+}
+
+// NewNetwork returns an empty Network.
+func NewNetwork() *Network {
+	return &Network{
+		groups:        make(map[string]*groupLog),
+		contactGroups: make(map[string]*groupLog),
+	}
+}
+
+// Node is a fake Berty node serving protocoltypes.ProtocolServiceServer over
+// an in-memory listener.
+type Node struct {
+	protocoltypes.UnimplementedProtocolServiceServer
+
+	net *Network
+
+	AccountPK []byte
+	DevicePK  []byte
+
+	accountGroup *protocoltypes.Group
+	rdvSeed      []byte
+	rdvEnabled   bool
+	members      map[string][]byte
+	active       map[string]bool
+	incoming     map[string]bool
+	contacts     map[string][]byte
+	blocked      map[string]bool
+	// live counts the list streams waiting for new events.
+	live int
+
+	lis    *bufconn.Listener
+	server *grpc.Server
+}
+
+// NewNode creates a node on the network and starts serving it. Incoming
+// contact requests are enabled on new nodes.
+func (n *Network) NewNode() *Node {
+	node := &Node{
+		net:          n,
+		AccountPK:    randBytes(),
+		DevicePK:     randBytes(),
+		accountGroup: newGroup(protocoltypes.GroupTypeAccount),
+		rdvSeed:      randBytes(),
+		rdvEnabled:   true,
+		members:      make(map[string][]byte),
+		active:       make(map[string]bool),
+		incoming:     make(map[string]bool),
+		contacts:     make(map[string][]byte),
+		blocked:      make(map[string]bool),
+		lis:          bufconn.Listen(bufSize),
+		server:       grpc.NewServer(),
+	}
+
+	n.mu.Lock()
+	n.groups[key(node.accountGroup.PublicKey)] = newGroupLog(node.accountGroup)
+	node.members[key(node.accountGroup.PublicKey)] = node.AccountPK
+	node.active[key(node.accountGroup.PublicKey)] = true
+	n.nodes = append(n.nodes, node)
+	n.mu.Unlock()
+
+	protocoltypes.RegisterProtocolServiceServer(node.server, node)
+	go func() { _ = node.server.Serve(node.lis) }()
+
+	return node
+}
+
+// DialOption returns the option to give grpc.Dial, along with Addr, to
+// connect to the node.
+func (n *Node) DialOption() grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return n.lis.DialContext(ctx)
+	})
+}
+
+// Dial returns a client connection to the node.
+func (n *Node) Dial(ctx context.Context) (*grpc.ClientConn, error) {
+	return grpc.DialContext(ctx, Addr, n.DialOption(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// WaitLiveStreams waits until count list streams of the node wait for new
+// events, so that the events added next reach them.
+func (n *Node) WaitLiveStreams(ctx context.Context, count int) error {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		n.net.mu.Lock()
+		live := n.live
+		n.net.mu.Unlock()
+		if live >= count {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d live streams out of %d: %w", live, count, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close stops serving the node.
+func (n *Node) Close() {
+	n.server.Stop()
+	_ = n.lis.Close()
+}
+
+func (n *Network) nodeByAccount(pk []byte) *Node {
+	for _, node := range n.nodes {
+		if key(node.AccountPK) == key(pk) {
+			return node
+		}
+	}
+	return nil
+}
+
+func newGroup(groupType protocoltypes.GroupType) *protocoltypes.Group {
+	return &protocoltypes.Group{
+		PublicKey: randBytes(),
+		Secret:    randBytes(),
+		SecretSig: randBytes(),
+		GroupType: groupType,
+		SignPub:   randBytes(),
+	}
+}
+
+func randBytes() []byte {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Errorf("rand error: %w", err))
+	}
+	return b
+}
+
+func key(b []byte) string {
+	return string(b)
+}
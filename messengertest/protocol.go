@@ -0,0 +1,500 @@
+package messengertest
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ protocoltypes.ProtocolServiceServer = (*Node)(nil)
+
+func (n *Node) InstanceGetConfiguration(context.Context, *protocoltypes.InstanceGetConfiguration_Request) (*protocoltypes.InstanceGetConfiguration_Reply, error) {
+	return &protocoltypes.InstanceGetConfiguration_Reply{
+		AccountPK:      n.AccountPK,
+		DevicePK:       n.DevicePK,
+		AccountGroupPK: n.accountGroup.PublicKey,
+	}, nil
+}
+
+func (n *Node) ContactRequestReference(context.Context, *protocoltypes.ContactRequestReference_Request) (*protocoltypes.ContactRequestReference_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	return &protocoltypes.ContactRequestReference_Reply{
+		PublicRendezvousSeed: n.rdvSeed,
+		Enabled:              n.rdvEnabled,
+	}, nil
+}
+
+func (n *Node) ContactRequestEnable(context.Context, *protocoltypes.ContactRequestEnable_Request) (*protocoltypes.ContactRequestEnable_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	n.rdvEnabled = true
+	if _, err := n.accountLog().addMetadata(protocoltypes.EventTypeAccountContactRequestEnabled, &protocoltypes.AccountContactRequestEnabled{
+		DevicePK: n.DevicePK,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.ContactRequestEnable_Reply{PublicRendezvousSeed: n.rdvSeed}, nil
+}
+
+func (n *Node) ContactRequestDisable(context.Context, *protocoltypes.ContactRequestDisable_Request) (*protocoltypes.ContactRequestDisable_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	n.rdvEnabled = false
+	if _, err := n.accountLog().addMetadata(protocoltypes.EventTypeAccountContactRequestDisabled, &protocoltypes.AccountContactRequestDisabled{
+		DevicePK: n.DevicePK,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.ContactRequestDisable_Reply{}, nil
+}
+
+func (n *Node) ContactRequestResetReference(context.Context, *protocoltypes.ContactRequestResetReference_Request) (*protocoltypes.ContactRequestResetReference_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	n.rdvSeed = randBytes()
+	if _, err := n.accountLog().addMetadata(protocoltypes.EventTypeAccountContactRequestReferenceReset, &protocoltypes.AccountContactRequestReferenceReset{
+		DevicePK:             n.DevicePK,
+		PublicRendezvousSeed: n.rdvSeed,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.ContactRequestResetReference_Reply{PublicRendezvousSeed: n.rdvSeed}, nil
+}
+
+func (n *Node) ContactRequestSend(_ context.Context, req *protocoltypes.ContactRequestSend_Request) (*protocoltypes.ContactRequestSend_Reply, error) {
+	if req.Contact == nil || len(req.Contact.PK) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing contact")
+	}
+	if bytes.Equal(req.Contact.PK, n.AccountPK) {
+		return nil, status.Error(codes.InvalidArgument, "cannot send a contact request to self")
+	}
+
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	if _, ok := n.contacts[key(req.Contact.PK)]; ok {
+		return nil, status.Error(codes.AlreadyExists, "contact already added")
+	}
+
+	account := n.accountLog()
+	if _, err := account.addMetadata(protocoltypes.EventTypeAccountContactRequestOutgoingEnqueued, &protocoltypes.AccountContactRequestEnqueued{
+		DevicePK:    n.DevicePK,
+		Contact:     req.Contact,
+		OwnMetadata: req.OwnMetadata,
+	}); err != nil {
+		return nil, err
+	}
+
+	peer := n.net.nodeByAccount(req.Contact.PK)
+	if peer == nil || !peer.rdvEnabled || !bytes.Equal(peer.rdvSeed, req.Contact.PublicRendezvousSeed) {
+		// the request stays enqueued, as it would until the peer is reachable
+		return &protocoltypes.ContactRequestSend_Reply{}, nil
+	}
+
+	if _, err := account.addMetadata(protocoltypes.EventTypeAccountContactRequestOutgoingSent, &protocoltypes.AccountContactRequestSent{
+		DevicePK:  n.DevicePK,
+		ContactPK: req.Contact.PK,
+	}); err != nil {
+		return nil, err
+	}
+
+	if peer.blocked[key(n.AccountPK)] {
+		return &protocoltypes.ContactRequestSend_Reply{}, nil
+	}
+
+	peer.incoming[key(n.AccountPK)] = true
+	if _, err := peer.accountLog().addMetadata(protocoltypes.EventTypeAccountContactRequestIncomingReceived, &protocoltypes.AccountContactRequestReceived{
+		DevicePK:              peer.DevicePK,
+		ContactPK:             n.AccountPK,
+		ContactRendezvousSeed: n.rdvSeed,
+		ContactMetadata:       req.OwnMetadata,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.ContactRequestSend_Reply{}, nil
+}
+
+func (n *Node) ContactRequestAccept(_ context.Context, req *protocoltypes.ContactRequestAccept_Request) (*protocoltypes.ContactRequestAccept_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	if !n.incoming[key(req.ContactPK)] {
+		return nil, status.Error(codes.NotFound, "no pending contact request")
+	}
+	delete(n.incoming, key(req.ContactPK))
+
+	peer := n.net.nodeByAccount(req.ContactPK)
+	if peer == nil {
+		return nil, status.Error(codes.Unavailable, "contact unreachable")
+	}
+
+	group := n.net.contactGroup(n, peer)
+	if _, err := n.accountLog().addMetadata(protocoltypes.EventTypeAccountContactRequestIncomingAccepted, &protocoltypes.AccountContactRequestAccepted{
+		DevicePK:  n.DevicePK,
+		ContactPK: req.ContactPK,
+		GroupPK:   group.group.PublicKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, node := range []*Node{n, peer} {
+		if err := node.join(group); err != nil {
+			return nil, err
+		}
+	}
+	n.contacts[key(peer.AccountPK)] = group.group.PublicKey
+	peer.contacts[key(n.AccountPK)] = group.group.PublicKey
+
+	return &protocoltypes.ContactRequestAccept_Reply{}, nil
+}
+
+func (n *Node) ContactRequestDiscard(_ context.Context, req *protocoltypes.ContactRequestDiscard_Request) (*protocoltypes.ContactRequestDiscard_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	if !n.incoming[key(req.ContactPK)] {
+		return nil, status.Error(codes.NotFound, "no pending contact request")
+	}
+	delete(n.incoming, key(req.ContactPK))
+
+	if _, err := n.accountLog().addMetadata(protocoltypes.EventTypeAccountContactRequestIncomingDiscarded, &protocoltypes.AccountContactRequestDiscarded{
+		DevicePK:  n.DevicePK,
+		ContactPK: req.ContactPK,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.ContactRequestDiscard_Reply{}, nil
+}
+
+func (n *Node) ContactBlock(_ context.Context, req *protocoltypes.ContactBlock_Request) (*protocoltypes.ContactBlock_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	if n.blocked[key(req.ContactPK)] {
+		return nil, status.Error(codes.AlreadyExists, "contact already blocked")
+	}
+	n.blocked[key(req.ContactPK)] = true
+
+	if _, err := n.accountLog().addMetadata(protocoltypes.EventTypeAccountContactBlocked, &protocoltypes.AccountContactBlocked{
+		DevicePK:  n.DevicePK,
+		ContactPK: req.ContactPK,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.ContactBlock_Reply{}, nil
+}
+
+func (n *Node) ContactUnblock(_ context.Context, req *protocoltypes.ContactUnblock_Request) (*protocoltypes.ContactUnblock_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	if !n.blocked[key(req.ContactPK)] {
+		return nil, status.Error(codes.NotFound, "contact not blocked")
+	}
+	delete(n.blocked, key(req.ContactPK))
+
+	if _, err := n.accountLog().addMetadata(protocoltypes.EventTypeAccountContactUnblocked, &protocoltypes.AccountContactUnblocked{
+		DevicePK:  n.DevicePK,
+		ContactPK: req.ContactPK,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.ContactUnblock_Reply{}, nil
+}
+
+func (n *Node) GroupInfo(_ context.Context, req *protocoltypes.GroupInfo_Request) (*protocoltypes.GroupInfo_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	groupPK := req.GroupPK
+	if len(req.ContactPK) != 0 {
+		var ok bool
+		if groupPK, ok = n.contacts[key(req.ContactPK)]; !ok {
+			return nil, status.Error(codes.NotFound, "unknown contact")
+		}
+	}
+
+	group, ok := n.net.groups[key(groupPK)]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown group")
+	}
+
+	return &protocoltypes.GroupInfo_Reply{
+		Group:    group.group,
+		MemberPK: n.members[key(groupPK)],
+		DevicePK: n.DevicePK,
+	}, nil
+}
+
+func (n *Node) MultiMemberGroupCreate(context.Context, *protocoltypes.MultiMemberGroupCreate_Request) (*protocoltypes.MultiMemberGroupCreate_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	group := newGroupLog(newGroup(protocoltypes.GroupTypeMultiMember))
+	n.net.groups[key(group.group.PublicKey)] = group
+
+	if err := n.joinMultiMember(group); err != nil {
+		return nil, err
+	}
+	if _, err := group.addMetadata(protocoltypes.EventTypeMultiMemberGroupInitialMemberAnnounced, &protocoltypes.MultiMemberInitialMember{
+		MemberPK: n.members[key(group.group.PublicKey)],
+	}); err != nil {
+		return nil, err
+	}
+	group.admins[key(n.members[key(group.group.PublicKey)])] = true
+
+	return &protocoltypes.MultiMemberGroupCreate_Reply{GroupPK: group.group.PublicKey}, nil
+}
+
+func (n *Node) MultiMemberGroupAdminRoleGrant(_ context.Context, req *protocoltypes.MultiMemberGroupAdminRoleGrant_Request) (*protocoltypes.MultiMemberGroupAdminRoleGrant_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	group, err := n.memberGroup(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+	if !group.admins[key(n.members[key(req.GroupPK)])] {
+		return nil, status.Error(codes.PermissionDenied, "not an admin of the group")
+	}
+
+	if _, err := group.addMetadata(protocoltypes.EventTypeMultiMemberGroupAdminRoleGranted, &protocoltypes.MultiMemberGroupAdminRoleGrant{
+		DevicePK:        n.DevicePK,
+		GranteeMemberPK: req.MemberPK,
+	}); err != nil {
+		return nil, err
+	}
+	group.admins[key(req.MemberPK)] = true
+
+	return &protocoltypes.MultiMemberGroupAdminRoleGrant_Reply{}, nil
+}
+
+func (n *Node) MultiMemberGroupInvitationCreate(_ context.Context, req *protocoltypes.MultiMemberGroupInvitationCreate_Request) (*protocoltypes.MultiMemberGroupInvitationCreate_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	group, err := n.memberGroup(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.MultiMemberGroupInvitationCreate_Reply{Group: group.group}, nil
+}
+
+func (n *Node) MultiMemberGroupJoin(_ context.Context, req *protocoltypes.MultiMemberGroupJoin_Request) (*protocoltypes.MultiMemberGroupJoin_Reply, error) {
+	if req.Group == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing group")
+	}
+
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	group, ok := n.net.groups[key(req.Group.PublicKey)]
+	if !ok || !bytes.Equal(group.group.Secret, req.Group.Secret) {
+		return nil, status.Error(codes.NotFound, "unknown group")
+	}
+	if _, ok := n.members[key(req.Group.PublicKey)]; ok {
+		return nil, status.Error(codes.AlreadyExists, "group already joined")
+	}
+
+	if err := n.joinMultiMember(group); err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.MultiMemberGroupJoin_Reply{}, nil
+}
+
+func (n *Node) MultiMemberGroupLeave(_ context.Context, req *protocoltypes.MultiMemberGroupLeave_Request) (*protocoltypes.MultiMemberGroupLeave_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	group, err := n.memberGroup(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+	if group.group.GroupType != protocoltypes.GroupTypeMultiMember {
+		return nil, status.Error(codes.InvalidArgument, "not a multi member group")
+	}
+
+	if _, err := n.accountLog().addMetadata(protocoltypes.EventTypeAccountGroupLeft, &protocoltypes.AccountGroupLeft{
+		DevicePK: n.DevicePK,
+		GroupPK:  req.GroupPK,
+	}); err != nil {
+		return nil, err
+	}
+	delete(n.members, key(req.GroupPK))
+	delete(n.active, key(req.GroupPK))
+
+	return &protocoltypes.MultiMemberGroupLeave_Reply{}, nil
+}
+
+func (n *Node) ActivateGroup(_ context.Context, req *protocoltypes.ActivateGroup_Request) (*protocoltypes.ActivateGroup_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	if _, err := n.memberGroup(req.GroupPK); err != nil {
+		return nil, err
+	}
+	n.active[key(req.GroupPK)] = true
+
+	return &protocoltypes.ActivateGroup_Reply{}, nil
+}
+
+func (n *Node) DeactivateGroup(_ context.Context, req *protocoltypes.DeactivateGroup_Request) (*protocoltypes.DeactivateGroup_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	delete(n.active, key(req.GroupPK))
+
+	return &protocoltypes.DeactivateGroup_Reply{}, nil
+}
+
+func (n *Node) AppMessageSend(_ context.Context, req *protocoltypes.AppMessageSend_Request) (*protocoltypes.AppMessageSend_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	group, err := n.activeGroup(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.AppMessageSend_Reply{CID: group.addMessage(n.DevicePK, req.Payload)}, nil
+}
+
+func (n *Node) AppMetadataSend(_ context.Context, req *protocoltypes.AppMetadataSend_Request) (*protocoltypes.AppMetadataSend_Reply, error) {
+	n.net.mu.Lock()
+	defer n.net.mu.Unlock()
+
+	group, err := n.activeGroup(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := group.addMetadata(protocoltypes.EventTypeGroupMetadataPayloadSent, &protocoltypes.AppMetadata{
+		DevicePK: n.DevicePK,
+		Message:  req.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocoltypes.AppMetadataSend_Reply{CID: id}, nil
+}
+
+func (n *Node) GroupMetadataList(req *protocoltypes.GroupMetadataList_Request, srv protocoltypes.ProtocolService_GroupMetadataListServer) error {
+	n.net.mu.Lock()
+	group, err := n.activeGroup(req.GroupPK)
+	n.net.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return stream(srv.Context(), &n.net.mu, &group.metadata, listParams{
+		sinceID:  req.SinceID,
+		sinceNow: req.SinceNow,
+		untilID:  req.UntilID,
+		untilNow: req.UntilNow,
+		reverse:  req.ReverseOrder,
+	}, &n.live, srv.Send)
+}
+
+func (n *Node) GroupMessageList(req *protocoltypes.GroupMessageList_Request, srv protocoltypes.ProtocolService_GroupMessageListServer) error {
+	n.net.mu.Lock()
+	group, err := n.activeGroup(req.GroupPK)
+	n.net.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return stream(srv.Context(), &n.net.mu, &group.messages, listParams{
+		sinceID:  req.SinceID,
+		sinceNow: req.SinceNow,
+		untilID:  req.UntilID,
+		untilNow: req.UntilNow,
+		reverse:  req.ReverseOrder,
+	}, &n.live, srv.Send)
+}
+
+// The helpers below expect n.net.mu to be held.
+
+func (n *Node) accountLog() *groupLog {
+	return n.net.groups[key(n.accountGroup.PublicKey)]
+}
+
+func (n *Node) memberGroup(groupPK []byte) (*groupLog, error) {
+	group, ok := n.net.groups[key(groupPK)]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown group")
+	}
+	if _, ok := n.members[key(groupPK)]; !ok {
+		return nil, status.Error(codes.PermissionDenied, "not a member of the group")
+	}
+	return group, nil
+}
+
+func (n *Node) activeGroup(groupPK []byte) (*groupLog, error) {
+	group, err := n.memberGroup(groupPK)
+	if err != nil {
+		return nil, err
+	}
+	if !n.active[key(groupPK)] {
+		return nil, status.Error(codes.FailedPrecondition, "group not activated")
+	}
+	return group, nil
+}
+
+// join adds the node as a new member of group. Contact groups are always
+// active, like the account group.
+func (n *Node) join(group *groupLog) error {
+	memberPK := randBytes()
+	n.members[key(group.group.PublicKey)] = memberPK
+	if group.group.GroupType == protocoltypes.GroupTypeContact {
+		n.active[key(group.group.PublicKey)] = true
+	}
+
+	_, err := group.addMetadata(protocoltypes.EventTypeGroupMemberDeviceAdded, &protocoltypes.GroupAddMemberDevice{
+		MemberPK: memberPK,
+		DevicePK: n.DevicePK,
+	})
+	return err
+}
+
+func (n *Node) joinMultiMember(group *groupLog) error {
+	if _, err := n.accountLog().addMetadata(protocoltypes.EventTypeAccountGroupJoined, &protocoltypes.AccountGroupJoined{
+		DevicePK: n.DevicePK,
+		Group:    group.group,
+	}); err != nil {
+		return err
+	}
+	return n.join(group)
+}
+
+// contactGroup returns the group shared by a and b, creating it if needed.
+func (n *Network) contactGroup(a, b *Node) *groupLog {
+	pks := [][]byte{a.AccountPK, b.AccountPK}
+	sort.Slice(pks, func(i, j int) bool { return bytes.Compare(pks[i], pks[j]) < 0 })
+	if group, ok := n.contactGroups[key(bytes.Join(pks, nil))]; ok {
+		return group
+	}
+
+	group := newGroupLog(newGroup(protocoltypes.GroupTypeContact))
+	n.contactGroups[key(bytes.Join(pks, nil))] = group
+	n.groups[key(group.group.PublicKey)] = group
+	return group
+}
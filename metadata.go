@@ -0,0 +1,76 @@
+package messenger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+)
+
+// replayMetadata calls fn with each metadata event of the group, up to now.
+func (s *Service) replayMetadata(ctx context.Context, groupPK []byte, fn func(*protocoltypes.GroupMetadataEvent) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cl, err := s.client.GroupMetadataList(ctx, &protocoltypes.GroupMetadataList_Request{
+		GroupPK:  groupPK,
+		UntilNow: true,
+	})
+	if err != nil {
+		return fmt.Errorf("list error: %w", err)
+	}
+
+	for {
+		meta, err := cl.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("recv error: %w", err)
+		}
+
+		if meta == nil || meta.Metadata == nil {
+			continue
+		}
+		if err := fn(meta); err != nil {
+			return err
+		}
+	}
+}
+
+// tailMetadata calls fn with each metadata event of the group following
+// sinceID, until ctx is done. Without sinceID, it starts from now or, if
+// replay is set, from the start of the group.
+func (s *Service) tailMetadata(ctx context.Context, groupPK, sinceID []byte, replay bool, fn func(*protocoltypes.GroupMetadataEvent) error) error {
+	cl, err := s.client.GroupMetadataList(ctx, &protocoltypes.GroupMetadataList_Request{
+		GroupPK:  groupPK,
+		SinceID:  sinceID,
+		SinceNow: sinceID == nil && !replay,
+	})
+	if err != nil {
+		return fmt.Errorf("list error: %w", err)
+	}
+
+	for {
+		meta, err := cl.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("recv error: %w", err)
+		}
+
+		// the event given as starting point was already seen
+		if sinceID != nil && bytes.Equal(meta.EventContext.GetID(), sinceID) {
+			continue
+		}
+		if meta.Metadata == nil {
+			continue
+		}
+		if err := fn(meta); err != nil {
+			return err
+		}
+	}
+}
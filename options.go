@@ -0,0 +1,134 @@
+package messenger
+
+import (
+	"crypto/tls"
+	"io"
+	"log"
+	"time"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	datastore "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Option configures a Service created by NewWithOptions.
+type Option func(*options)
+
+type options struct {
+	nodeAddr    string
+	creds       credentials.TransportCredentials
+	dialTimeout time.Duration
+	dialOpts    []grpc.DialOption
+	logger      *log.Logger
+	client      protocoltypes.ProtocolServiceClient
+	store       datastore.Datastore
+	rotateEvery time.Duration
+
+	contactPolicy *ContactPolicy
+	adminPolicy   *AdminPolicy
+}
+
+func defaultOptions() options {
+	return options{
+		logger: log.New(io.Discard, "", 0),
+		store:  dssync.MutexWrap(datastore.NewMapDatastore()),
+	}
+}
+
+// WithNodeAddr sets the address of the Berty node to dial.
+func WithNodeAddr(addr string) Option {
+	return func(o *options) {
+		o.nodeAddr = addr
+	}
+}
+
+// WithTransportCredentials sets the credentials used to connect to the node.
+// The connection is insecure by default.
+func WithTransportCredentials(creds credentials.TransportCredentials) Option {
+	return func(o *options) {
+		o.creds = creds
+	}
+}
+
+// WithTLSConfig connects to the node over TLS using cfg. Setting
+// cfg.Certificates enables mutual TLS.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return WithTransportCredentials(credentials.NewTLS(cfg))
+}
+
+// WithDialTimeout makes NewWithOptions wait up to d for the node to be
+// reachable and fail otherwise. By default the connection is established in
+// the background.
+func WithDialTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.dialTimeout = d
+	}
+}
+
+// WithDialOptions appends opts to the options used to dial the node.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOpts = append(o.dialOpts, opts...)
+	}
+}
+
+// WithLogger sets the logger used by the service. Logs are discarded by default.
+func WithLogger(logger *log.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithProtocolClient makes the service use client instead of dialing a node.
+// Dial related options are ignored and the client is not closed by Close.
+func WithProtocolClient(client protocoltypes.ProtocolServiceClient) Option {
+	return func(o *options) {
+		o.client = client
+	}
+}
+
+// WithDatastore sets where the service keeps its own state, such as the time
+// at which events were first seen. The state is kept in memory by default.
+func WithDatastore(store datastore.Datastore) Option {
+	return func(o *options) {
+		o.store = store
+	}
+}
+
+// WithReferenceRotation resets the contact request reference every d, so that
+// a contact card posted publicly stops working after a while. The time of the
+// last reset is kept in the datastore and survives restarts.
+func WithReferenceRotation(d time.Duration) Option {
+	return func(o *options) {
+		o.rotateEvery = d
+	}
+}
+
+// WithContactPolicy makes the service accept, discard or hold incoming contact
+// requests according to p, including those pending when it starts.
+func WithContactPolicy(p *ContactPolicy) Option {
+	return func(o *options) {
+		o.contactPolicy = p
+	}
+}
+
+// WithAdminPolicy makes the service reject the operations selected by p with
+// PermissionDenied unless our member is an admin of the group.
+func WithAdminPolicy(p *AdminPolicy) Option {
+	return func(o *options) {
+		o.adminPolicy = p
+	}
+}
+
+func (o *options) dialOptions() []grpc.DialOption {
+	var opts []grpc.DialOption
+	if o.creds != nil {
+		opts = append(opts, grpc.WithTransportCredentials(o.creds))
+	}
+	if o.dialTimeout > 0 {
+		opts = append(opts, grpc.WithBlock(), grpc.WithReturnConnectionError())
+	}
+	return append(opts, o.dialOpts...)
+}
@@ -0,0 +1,57 @@
+package messenger
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// envelopeMagic prefixes the payloads framed in an Envelope, to tell them
+// apart from the raw payloads sent by other clients.
+var envelopeMagic = []byte("akm\x01")
+
+// encodePayload returns the bytes to send for req. Payloads without content
+// type are sent raw.
+func encodePayload(req *SendMessageReq) ([]byte, error) {
+	payload := req.Payload
+	if payload == nil {
+		payload = []byte(req.Message)
+	}
+
+	if req.ContentType == "" {
+		return payload, nil
+	}
+
+	envelope, err := proto.Marshal(&Envelope{
+		ContentType: req.ContentType,
+		Payload:     payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, envelopeMagic...), envelope...), nil
+}
+
+// decodePayload returns the payload and content type of a received message.
+func decodePayload(raw []byte) ([]byte, string) {
+	if bytes.HasPrefix(raw, envelopeMagic) {
+		envelope := &Envelope{}
+		if err := proto.Unmarshal(raw[len(envelopeMagic):], envelope); err == nil {
+			return envelope.Payload, envelope.ContentType
+		}
+	}
+
+	return raw, ""
+}
+
+// textOf returns payload as a string that can be sent in a proto string
+// field, and whether payload was valid UTF-8.
+func textOf(payload []byte) (string, bool) {
+	if utf8.Valid(payload) {
+		return string(payload), true
+	}
+	return strings.ToValidUTF8(string(payload), string(utf8.RuneError)), false
+}
@@ -0,0 +1,273 @@
+package messenger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"google.golang.org/protobuf/proto"
+)
+
+var decisionsKey = datastore.NewKey("/policy/decisions")
+
+// ContactPolicy decides what to do with incoming contact requests, see
+// WithContactPolicy.
+type ContactPolicy struct {
+	// Allow lists the base64 account keys whose requests are always accepted.
+	Allow []string
+	// Rules are tried in order, the first matching one applies.
+	Rules []ContactRule
+	// MaxAccepts caps the requests accepted in any Per period, the others
+	// being held. Allowed keys are not capped. There is no cap if it is 0.
+	MaxAccepts int
+	Per        time.Duration
+	// Default applies to the requests no rule matched.
+	Default ContactAction
+}
+
+// ContactRule applies Action to the requests whose card matches Pattern.
+type ContactRule struct {
+	// Pattern is matched against the display name and the intro of the card.
+	Pattern *regexp.Regexp
+	Action  ContactAction
+}
+
+// validate reports the rules decide could not apply.
+func (p *ContactPolicy) validate() error {
+	for i, rule := range p.Rules {
+		if rule.Pattern == nil {
+			return fmt.Errorf("rule %d has no pattern", i)
+		}
+	}
+	if p.MaxAccepts > 0 && p.Per <= 0 {
+		return errors.New("rate cap has no period")
+	}
+
+	return nil
+}
+
+const reasonAllowed = "allowlisted"
+
+// decide returns the action for a request from contact, without rate cap.
+func (p *ContactPolicy) decide(contact *Contact) (ContactAction, string) {
+	for _, pk := range p.Allow {
+		if pk == contact.PublicKey {
+			return ContactAction_CONTACT_ACTION_ACCEPT, reasonAllowed
+		}
+	}
+
+	for i, rule := range p.Rules {
+		if rule.Pattern.MatchString(contact.Card.GetDisplayName()) || rule.Pattern.MatchString(contact.Card.GetIntro()) {
+			return rule.Action, fmt.Sprintf("rule %d matched", i)
+		}
+	}
+
+	return p.Default, "default"
+}
+
+func (s *Service) ListContactDecisions(ctx context.Context, _ *ListContactDecisionsReq) (*ListContactDecisionsRes, error) {
+	decisions, err := s.contactDecisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListContactDecisionsRes{Decisions: decisions}, nil
+}
+
+// runContactPolicy applies p to the pending and incoming contact requests
+// until the service is closed.
+func (s *Service) runContactPolicy(p *ContactPolicy) {
+	s.background(func(ctx context.Context) {
+		for {
+			err := s.applyContactPolicy(ctx, p)
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil {
+				// the pass ended for the held requests to be decided again
+				continue
+			}
+			s.logger.Printf("contact policy error: %v", err)
+			if !sleep(ctx, nodeBackoff.MaxDelay) {
+				return
+			}
+		}
+	})
+}
+
+// applyContactPolicy decides the pending requests, held ones included, then the
+// incoming ones. It returns nil once a request held by the rate cap may be
+// accepted, for the pass to be run again.
+func (s *Service) applyContactPolicy(ctx context.Context, p *ContactPolicy) error {
+	passCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var retry *time.Timer
+	defer func() {
+		if retry != nil {
+			retry.Stop()
+		}
+	}()
+	retryAt := func(t time.Time) {
+		if retry == nil {
+			retry = time.AfterFunc(time.Until(t), cancel)
+		}
+	}
+
+	err := s.decidePending(passCtx, p, retryAt)
+	if passCtx.Err() != nil && ctx.Err() == nil {
+		return nil
+	}
+	return err
+}
+
+// decidePending runs a pass of the policy until ctx is done.
+func (s *Service) decidePending(ctx context.Context, p *ContactPolicy, retryAt func(time.Time)) error {
+	book, err := s.replayContactBook(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, contact := range book.contacts {
+		if contact.State != ContactState_CONTACT_STATE_INCOMING_RECEIVED || contact.Blocked {
+			continue
+		}
+		if err := s.decideContact(ctx, p, book.receivedIDs[contact.PublicKey], contact, retryAt); err != nil {
+			return err
+		}
+	}
+
+	return s.tailContactBook(ctx, book, false, func(meta *protocoltypes.GroupMetadataEvent, contact *Contact) error {
+		if meta.Metadata.EventType != protocoltypes.EventTypeAccountContactRequestIncomingReceived || contact.Blocked {
+			return nil
+		}
+		return s.decideContact(ctx, p, meta.EventContext.GetID(), contact, retryAt)
+	})
+}
+
+// decideContact applies p to the request eventID from contact, unless it was
+// already accepted or discarded. A request held by the rate cap makes retryAt
+// called with the time it may be accepted.
+func (s *Service) decideContact(ctx context.Context, p *ContactPolicy, eventID []byte, contact *Contact, retryAt func(time.Time)) error {
+	id, err := cidString(eventID)
+	if err != nil {
+		return fmt.Errorf("event id error: %w", err)
+	}
+	key := decisionsKey.ChildString(id)
+
+	previous := &ContactDecision{}
+	switch raw, err := s.store.Get(ctx, key); {
+	case errors.Is(err, datastore.ErrNotFound):
+		previous = nil
+	case err != nil:
+		return fmt.Errorf("store error: %w", err)
+	default:
+		if err := proto.Unmarshal(raw, previous); err != nil {
+			return fmt.Errorf("decision error: %w", err)
+		}
+		if previous.Action != ContactAction_CONTACT_ACTION_HOLD {
+			return nil
+		}
+	}
+
+	action, reason := p.decide(contact)
+	if action == ContactAction_CONTACT_ACTION_ACCEPT && reason != reasonAllowed && p.MaxAccepts > 0 {
+		accepted, err := s.acceptedSince(ctx, time.Now().Add(-p.Per))
+		if err != nil {
+			return err
+		}
+		if len(accepted) >= p.MaxAccepts {
+			action, reason = ContactAction_CONTACT_ACTION_HOLD, "rate limited"
+			// the cap frees up once enough accepts leave the window
+			retryAt(accepted[len(accepted)-p.MaxAccepts].Add(p.Per + time.Second))
+		}
+	}
+	if previous != nil && action == previous.Action && reason == previous.Reason {
+		return nil
+	}
+
+	switch action {
+	case ContactAction_CONTACT_ACTION_ACCEPT:
+		_, err = s.AcceptContactRequest(ctx, &AcceptContactRequestReq{Pubkey: contact.PublicKey})
+	case ContactAction_CONTACT_ACTION_DISCARD:
+		_, err = s.DiscardContactRequest(ctx, &DiscardContactRequestReq{Pubkey: contact.PublicKey})
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// hold the request rather than retry it forever, the user can still
+		// decide it by hand
+		op := "accept"
+		if action == ContactAction_CONTACT_ACTION_DISCARD {
+			op = "discard"
+		}
+		s.logger.Printf("contact request from %s: %s error: %v", contact.PublicKey, op, err)
+		action, reason = ContactAction_CONTACT_ACTION_HOLD, fmt.Sprintf("%s failed: %v", op, err)
+	}
+
+	raw, err := proto.Marshal(&ContactDecision{
+		Id:        id,
+		PublicKey: contact.PublicKey,
+		Name:      contact.Name,
+		Action:    action,
+		Reason:    reason,
+		DecidedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.store.Put(ctx, key, raw); err != nil {
+		return fmt.Errorf("store error: %w", err)
+	}
+
+	s.logger.Printf("contact request from %s: %s, %s", contact.PublicKey, action, reason)
+	return nil
+}
+
+// acceptedSince returns when the requests accepted by the policy since t were
+// decided, oldest first.
+func (s *Service) acceptedSince(ctx context.Context, t time.Time) ([]time.Time, error) {
+	decisions, err := s.contactDecisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var accepted []time.Time
+	for _, decision := range decisions {
+		if decision.Action == ContactAction_CONTACT_ACTION_ACCEPT && decision.DecidedAt >= t.Unix() {
+			accepted = append(accepted, time.Unix(decision.DecidedAt, 0))
+		}
+	}
+	return accepted, nil
+}
+
+// contactDecisions returns the decisions of the policy, oldest first.
+func (s *Service) contactDecisions(ctx context.Context) ([]*ContactDecision, error) {
+	results, err := s.store.Query(ctx, query.Query{Prefix: decisionsKey.String(), Orders: []query.Order{query.OrderByKey{}}})
+	if err != nil {
+		return nil, fmt.Errorf("store error: %w", err)
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return nil, fmt.Errorf("store error: %w", err)
+	}
+
+	decisions := make([]*ContactDecision, 0, len(entries))
+	for _, entry := range entries {
+		decision := &ContactDecision{}
+		if err := proto.Unmarshal(entry.Value, decision); err != nil {
+			return nil, fmt.Errorf("decision error: %w", err)
+		}
+		decisions = append(decisions, decision)
+	}
+	sort.SliceStable(decisions, func(i, j int) bool { return decisions[i].DecidedAt < decisions[j].DecidedAt })
+
+	return decisions, nil
+}
@@ -0,0 +1,182 @@
+package messenger
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// appMetadataMagic prefixes the app metadata sent by the module, to tell it
+// apart from the one sent by other clients.
+var appMetadataMagic = []byte("akd\x01")
+
+func (s *Service) UpdateGroupProfile(ctx context.Context, req *UpdateGroupProfileReq) (*UpdateGroupProfileRes, error) {
+	group, err := s.groupInfo(ctx, req.GroupPk, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.adminPolicy != nil && s.adminPolicy.Profile {
+		if err := s.requireAdmin(ctx, group); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, _, err := s.groupProfile(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	if req.Profile.GetName() != "" {
+		profile.Name = req.Profile.Name
+	}
+	if req.Profile.GetDescription() != "" {
+		profile.Description = req.Profile.Description
+	}
+	if len(req.Profile.GetAvatar()) != 0 {
+		profile.Avatar = req.Profile.Avatar
+	}
+
+	err = s.sendAppMetadata(ctx, group.Group.PublicKey, &AppMetadata{Event: &AppMetadata_GroupProfile{GroupProfile: profile}})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateGroupProfileRes{Success: true, Profile: profile}, nil
+}
+
+func (s *Service) GetGroupProfile(ctx context.Context, req *GetGroupProfileReq) (*GetGroupProfileRes, error) {
+	group, err := s.groupInfo(ctx, req.GroupPk, false)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, updatedBy, err := s.groupProfile(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.groupSettings(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetGroupProfileRes{Profile: profile, Settings: settings, UpdatedBy: updatedBy}, nil
+}
+
+func (s *Service) SetGroupSettings(ctx context.Context, req *SetGroupSettingsReq) (*SetGroupSettingsRes, error) {
+	groupPK, err := base64.StdEncoding.DecodeString(req.GroupPk)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+	if req.Settings == nil {
+		return nil, errors.New("missing settings")
+	}
+
+	restricted := s.adminPolicy != nil && (s.adminPolicy.Profile || s.adminPolicy.Announcements)
+	if err := s.requireAdminOf(ctx, groupPK, restricted); err != nil {
+		return nil, err
+	}
+
+	err = s.sendAppMetadata(ctx, groupPK, &AppMetadata{Event: &AppMetadata_GroupSettings{GroupSettings: req.Settings}})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetGroupSettingsRes{Success: true}, nil
+}
+
+// groupSettings returns the last settings published in the group by an admin.
+func (s *Service) groupSettings(ctx context.Context, group *protocoltypes.GroupInfo_Reply) (*GroupSettings, error) {
+	settings := &GroupSettings{}
+	err := s.replayGroupMetadata(ctx, group, true, func(_ *GroupMember, event *AppMetadata) {
+		if set := event.GetGroupSettings(); set != nil {
+			settings = set
+		}
+	})
+	return settings, err
+}
+
+// groupProfile returns the last profile published in the group and the
+// member that published it. With the Profile admin policy, only the profiles
+// published by an admin are honoured.
+func (s *Service) groupProfile(ctx context.Context, group *protocoltypes.GroupInfo_Reply) (*GroupProfile, string, error) {
+	profile := &GroupProfile{}
+	var updatedBy string
+	adminsOnly := s.adminPolicy != nil && s.adminPolicy.Profile
+	err := s.replayGroupMetadata(ctx, group, adminsOnly, func(author *GroupMember, event *AppMetadata) {
+		if p := event.GetGroupProfile(); p != nil {
+			profile, updatedBy = p, author.GetMemberPk()
+		}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return profile, updatedBy, nil
+}
+
+// replayGroupMetadata calls fn with each event sent with sendAppMetadata in the
+// group and the member that sent it, if known. If adminsOnly is set, only the
+// events sent by an admin at the time are honoured in multi member groups.
+func (s *Service) replayGroupMetadata(ctx context.Context, group *protocoltypes.GroupInfo_Reply, adminsOnly bool, fn func(*GroupMember, *AppMetadata)) error {
+	adminsOnly = adminsOnly && group.Group.GetGroupType() == protocoltypes.GroupTypeMultiMember
+
+	book := newMemberBook(group)
+	return s.replayMetadata(ctx, book.groupPK, func(meta *protocoltypes.GroupMetadataEvent) error {
+		if _, _, err := s.applyMemberEvent(ctx, book, meta); err != nil {
+			return err
+		}
+
+		casted, event, err := decodeAppMetadata(meta)
+		if err != nil || event == nil {
+			return err
+		}
+		author := book.byDevice[base64.StdEncoding.EncodeToString(casted.DevicePK)]
+		if adminsOnly && (author == nil || !author.Admin) {
+			return nil
+		}
+		fn(author, event)
+		return nil
+	})
+}
+
+// sendAppMetadata publishes event in the group metadata.
+func (s *Service) sendAppMetadata(ctx context.Context, groupPK []byte, event *AppMetadata) error {
+	raw, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.AppMetadataSend(ctx, &protocoltypes.AppMetadataSend_Request{
+		GroupPK: groupPK,
+		Payload: append(append([]byte{}, appMetadataMagic...), raw...),
+	})
+	if err != nil {
+		return fmt.Errorf("send metadata error: %w", err)
+	}
+	return nil
+}
+
+// decodeAppMetadata returns the event sent with sendAppMetadata in meta, or
+// nil if meta holds no such event.
+func decodeAppMetadata(meta *protocoltypes.GroupMetadataEvent) (*protocoltypes.AppMetadata, *AppMetadata, error) {
+	if meta.Metadata.EventType != protocoltypes.EventTypeGroupMetadataPayloadSent {
+		return nil, nil, nil
+	}
+	casted := &protocoltypes.AppMetadata{}
+	if err := casted.Unmarshal(meta.Event); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal error: %w", err)
+	}
+
+	if !bytes.HasPrefix(casted.Message, appMetadataMagic) {
+		return nil, nil, nil
+	}
+	event := &AppMetadata{}
+	if err := proto.Unmarshal(casted.Message[len(appMetadataMagic):], event); err != nil {
+		return nil, nil, nil
+	}
+	return casted, event, nil
+}
@@ -0,0 +1,92 @@
+package messenger
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	datastore "github.com/ipfs/go-datastore"
+)
+
+var rotatedKey = datastore.NewKey("/rendezvous/rotated")
+
+func (s *Service) EnableContactRequests(ctx context.Context, _ *EnableContactRequestsReq) (*EnableContactRequestsRes, error) {
+	res, err := s.client.ContactRequestEnable(ctx, &protocoltypes.ContactRequestEnable_Request{})
+	if err != nil {
+		return nil, fmt.Errorf("enable error: %w", err)
+	}
+
+	return &EnableContactRequestsRes{
+		RdvSeed: base64.StdEncoding.EncodeToString(res.PublicRendezvousSeed),
+	}, nil
+}
+
+func (s *Service) DisableContactRequests(ctx context.Context, _ *DisableContactRequestsReq) (*DisableContactRequestsRes, error) {
+	_, err := s.client.ContactRequestDisable(ctx, &protocoltypes.ContactRequestDisable_Request{})
+	if err != nil {
+		return nil, fmt.Errorf("disable error: %w", err)
+	}
+
+	return &DisableContactRequestsRes{Success: true}, nil
+}
+
+func (s *Service) ResetContactReference(ctx context.Context, _ *ResetContactReferenceReq) (*ResetContactReferenceRes, error) {
+	res, err := s.client.ContactRequestResetReference(ctx, &protocoltypes.ContactRequestResetReference_Request{})
+	if err != nil {
+		return nil, fmt.Errorf("reset error: %w", err)
+	}
+
+	if err := s.putTime(ctx, rotatedKey, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &ResetContactReferenceRes{
+		RdvSeed: base64.StdEncoding.EncodeToString(res.PublicRendezvousSeed),
+	}, nil
+}
+
+// rotateReferences resets the contact request reference every interval
+// until the service is closed.
+func (s *Service) rotateReferences(every time.Duration) {
+	s.background(func(ctx context.Context) {
+		for {
+			wait, err := s.untilRotation(ctx, every)
+			if err != nil {
+				s.logger.Printf("reference rotation error: %v", err)
+				wait = every
+			}
+			if !sleep(ctx, wait) {
+				return
+			}
+
+			if _, err := s.ResetContactReference(ctx, &ResetContactReferenceReq{}); err != nil {
+				s.logger.Printf("reference rotation error: %v", err)
+				if !sleep(ctx, nodeBackoff.MaxDelay) {
+					return
+				}
+				continue
+			}
+			s.logger.Printf("contact request reference rotated")
+		}
+	})
+}
+
+// untilRotation returns how long to wait before the next reset. The first
+// start of the service counts as a reset.
+func (s *Service) untilRotation(ctx context.Context, every time.Duration) (time.Duration, error) {
+	last, err := s.getTime(ctx, rotatedKey)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return every, s.putTime(ctx, rotatedKey, time.Now())
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if wait := time.Until(last.Add(every)); wait > 0 {
+		return wait, nil
+	}
+	return 0, nil
+}
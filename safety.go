@@ -0,0 +1,114 @@
+package messenger
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"berty.tech/berty/v2/go/pkg/protocoltypes"
+	datastore "github.com/ipfs/go-datastore"
+)
+
+var verifiedKey = datastore.NewKey("/contacts/verified")
+
+const (
+	safetyNumberVersion    = 0
+	safetyNumberIterations = 5200
+)
+
+func (s *Service) GetSafetyNumber(ctx context.Context, req *GetSafetyNumberReq) (*GetSafetyNumberRes, error) {
+	contactPK, err := base64.StdEncoding.DecodeString(req.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+	if len(contactPK) == 0 {
+		return nil, errors.New("missing contact public key")
+	}
+
+	config, err := s.client.InstanceGetConfiguration(ctx, &protocoltypes.InstanceGetConfiguration_Request{})
+	if err != nil {
+		return nil, fmt.Errorf("get config error: %w", err)
+	}
+
+	verified, err := s.isVerified(ctx, req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetSafetyNumberRes{
+		SafetyNumber: safetyNumber(config.AccountPK, contactPK),
+		Verified:     verified,
+	}, nil
+}
+
+func (s *Service) SetContactVerified(ctx context.Context, req *SetContactVerifiedReq) (*SetContactVerifiedRes, error) {
+	contactPK, err := base64.StdEncoding.DecodeString(req.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %w", err)
+	}
+	key := verifiedKey.ChildString(base64.RawURLEncoding.EncodeToString(contactPK))
+
+	if req.Verified {
+		err = s.putTime(ctx, key, time.Now())
+	} else if err = s.store.Delete(ctx, key); err != nil {
+		err = fmt.Errorf("store error: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &SetContactVerifiedRes{Success: true}, nil
+}
+
+// isVerified reports whether the contact with the base64 key pk was verified.
+func (s *Service) isVerified(ctx context.Context, pk string) (bool, error) {
+	contactPK, err := base64.StdEncoding.DecodeString(pk)
+	if err != nil {
+		return false, fmt.Errorf("decode error: %w", err)
+	}
+
+	verified, err := s.store.Has(ctx, verifiedKey.ChildString(base64.RawURLEncoding.EncodeToString(contactPK)))
+	if err != nil {
+		return false, fmt.Errorf("store error: %w", err)
+	}
+	return verified, nil
+}
+
+// safetyNumber returns the 60 digits fingerprint of the two account keys,
+// the lowest key fingerprint first so that both sides get the same number.
+func safetyNumber(a, b []byte) string {
+	fa, fb := fingerprint(a), fingerprint(b)
+	if fa > fb {
+		fa, fb = fb, fa
+	}
+	digits := fa + fb
+
+	groups := make([]string, 0, len(digits)/5)
+	for i := 0; i < len(digits); i += 5 {
+		groups = append(groups, digits[i:i+5])
+	}
+	return strings.Join(groups, " ")
+}
+
+// fingerprint returns 30 digits derived from pk with iterated SHA-512, making
+// collisions expensive to search for.
+func fingerprint(pk []byte) string {
+	hash := append(binary.BigEndian.AppendUint16(nil, safetyNumberVersion), pk...)
+	for i := 0; i < safetyNumberIterations; i++ {
+		sum := sha512.Sum512(append(hash, pk...))
+		hash = sum[:]
+	}
+
+	var digits bytes.Buffer
+	for i := 0; i < 30; i += 5 {
+		chunk := uint64(hash[i])<<32 | uint64(hash[i+1])<<24 | uint64(hash[i+2])<<16 | uint64(hash[i+3])<<8 | uint64(hash[i+4])
+		fmt.Fprintf(&digits, "%05d", chunk%100000)
+	}
+	return digits.String()
+}
@@ -0,0 +1,51 @@
+package messenger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	datastore "github.com/ipfs/go-datastore"
+)
+
+var seenKey = datastore.NewKey("/events/seen")
+
+// seenAt returns the time at which the event id was first seen by the
+// service, the protocol events carrying no wall clock time.
+func (s *Service) seenAt(ctx context.Context, id []byte) (time.Time, error) {
+	idStr, err := cidString(id)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("event id error: %w", err)
+	}
+	key := seenKey.ChildString(idStr)
+
+	seen, err := s.getTime(ctx, key)
+	if !errors.Is(err, datastore.ErrNotFound) {
+		return seen, err
+	}
+
+	now := time.Now()
+	return now, s.putTime(ctx, key, now)
+}
+
+func (s *Service) getTime(ctx context.Context, key datastore.Key) (time.Time, error) {
+	value, err := s.store.Get(ctx, key)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("store error: %w", err)
+	}
+
+	nanos, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("time error: %w", err)
+	}
+	return time.Unix(0, nanos), nil
+}
+
+func (s *Service) putTime(ctx context.Context, key datastore.Key, t time.Time) error {
+	if err := s.store.Put(ctx, key, []byte(strconv.FormatInt(t.UnixNano(), 10))); err != nil {
+		return fmt.Errorf("store error: %w", err)
+	}
+	return nil
+}
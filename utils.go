@@ -1,5 +1,9 @@
 package messenger
 
+import (
+	cid "github.com/ipfs/go-cid"
+)
+
 func RemoveMatch[T interface{}](a []T, f func(T) bool) []T {
 	for i, v := range a {
 		if f(v) {
@@ -9,3 +13,22 @@ func RemoveMatch[T interface{}](a []T, f func(T) bool) []T {
 	}
 	return a
 }
+
+// cidString returns the string form of a CID given in its binary form, as
+// used by the protocol for event IDs.
+func cidString(b []byte) (string, error) {
+	c, err := cid.Cast(b)
+	if err != nil {
+		return "", err
+	}
+	return c.String(), nil
+}
+
+// parseCID returns the binary form of a CID given in its string form.
+func parseCID(s string) ([]byte, error) {
+	c, err := cid.Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	return c.Bytes(), nil
+}